@@ -35,7 +35,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/mariadb/mgmt/2018-06-01/mariadb"
 	"github.com/Azure/azure-sdk-for-go/services/mediaservices/mgmt/2018-07-01/media"
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-02-01/network"
 	"github.com/Azure/azure-sdk-for-go/services/notificationhubs/mgmt/2017-04-01/notificationhubs"
 	"github.com/Azure/azure-sdk-for-go/services/postgresql/mgmt/2017-12-01/postgresql"
 	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-01-01-preview/authorization"
@@ -53,6 +53,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/signalr/mgmt/2018-03-01-preview/signalr"
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
 	MsSql "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-10-01-preview/sql"
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
 	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2016-06-01/recoveryservices"
 	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
 	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
@@ -66,6 +67,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
 	"github.com/Azure/azure-sdk-for-go/services/servicefabric/mgmt/2018-02-01/servicefabric"
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2018-02-01/storage"
+	storageMgmt "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
 	"github.com/Azure/azure-sdk-for-go/services/streamanalytics/mgmt/2016-03-01/streamanalytics"
 	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2018-04-01/trafficmanager"
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
@@ -78,6 +80,7 @@ import (
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform/httpclient"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tracing"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"github.com/terraform-providers/terraform-provider-azurerm/version"
 )
@@ -92,6 +95,24 @@ type ArmClient struct {
 	usingServicePrincipal    bool
 	environment              az.Environment
 	skipProviderRegistration bool
+	features                 features
+	tagsPolicy               tagsPolicy
+
+	// resourceProviderRegistrar registers Resource Providers on-demand, and is only
+	// set when `lazy_provider_registration` is enabled
+	resourceProviderRegistrar *resourceProviderRegistrar
+
+	// sender is shared between all of the clients so that retries and
+	// request throttling apply across the whole Subscription
+	sender autorest.Sender
+
+	// subscriptions contains the ArmClients for other Subscriptions, which are built on-demand using the
+	// same credentials when a resource references a resource in another Subscription
+	subscriptions *subscriptionClients
+
+	// tracer records a Span for each Terraform operation and each request made to Azure, and is
+	// only set when tracing is enabled
+	tracer *tracing.Tracer
 
 	StopContext context.Context
 
@@ -111,10 +132,15 @@ type ArmClient struct {
 	dnsClient   dns.RecordSetsClient
 	zonesClient dns.ZonesClient
 
+	privateDnsClient                    privatedns.RecordSetsClient
+	privateDnsZonesClient               privatedns.PrivateZonesClient
+	privateDnsVirtualNetworkLinksClient privatedns.VirtualNetworkLinksClient
+
 	containerRegistryClient             containerregistry.RegistriesClient
 	containerRegistryReplicationsClient containerregistry.ReplicationsClient
 	containerServicesClient             containerservice.ContainerServicesClient
 	kubernetesClustersClient            containerservice.ManagedClustersClient
+	kubernetesClusterNodePoolsClient    containerservice.AgentPoolsClient
 	containerGroupsClient               containerinstance.ContainerGroupsClient
 
 	eventGridDomainsClient            eventgrid.DomainsClient
@@ -183,19 +209,21 @@ type ArmClient struct {
 	cognitiveAccountsClient cognitiveservices.AccountsClient
 
 	// Compute
-	availSetClient             compute.AvailabilitySetsClient
-	diskClient                 compute.DisksClient
-	imageClient                compute.ImagesClient
-	galleriesClient            compute.GalleriesClient
-	galleryImagesClient        compute.GalleryImagesClient
-	galleryImageVersionsClient compute.GalleryImageVersionsClient
-	snapshotsClient            compute.SnapshotsClient
-	usageOpsClient             compute.UsageClient
-	vmExtensionImageClient     compute.VirtualMachineExtensionImagesClient
-	vmExtensionClient          compute.VirtualMachineExtensionsClient
-	vmScaleSetClient           compute.VirtualMachineScaleSetsClient
-	vmImageClient              compute.VirtualMachineImagesClient
-	vmClient                   compute.VirtualMachinesClient
+	availSetClient                  compute.AvailabilitySetsClient
+	diskClient                      compute.DisksClient
+	imageClient                     compute.ImagesClient
+	galleriesClient                 compute.GalleriesClient
+	galleryImagesClient             compute.GalleryImagesClient
+	galleryImageVersionsClient      compute.GalleryImageVersionsClient
+	snapshotsClient                 compute.SnapshotsClient
+	usageOpsClient                  compute.UsageClient
+	vmExtensionImageClient          compute.VirtualMachineExtensionImagesClient
+	vmExtensionClient               compute.VirtualMachineExtensionsClient
+	vmScaleSetClient                compute.VirtualMachineScaleSetsClient
+	vmScaleSetRollingUpgradesClient compute.VirtualMachineScaleSetRollingUpgradesClient
+	vmScaleSetVMsClient             compute.VirtualMachineScaleSetVMsClient
+	vmImageClient                   compute.VirtualMachineImagesClient
+	vmClient                        compute.VirtualMachinesClient
 
 	// Devices
 	iothubResourceClient devices.IotHubResourceClient
@@ -297,6 +325,7 @@ type ArmClient struct {
 	ifaceClient                     network.InterfacesClient
 	loadBalancerClient              network.LoadBalancersClient
 	localNetConnClient              network.LocalNetworkGatewaysClient
+	natGatewayClient                network.NatGatewaysClient
 	netProfileClient                network.ProfilesClient
 	packetCapturesClient            network.PacketCapturesClient
 	publicIPClient                  network.PublicIPAddressesClient
@@ -311,6 +340,7 @@ type ArmClient struct {
 	vnetClient                      network.VirtualNetworksClient
 	vnetPeeringsClient              network.VirtualNetworkPeeringsClient
 	watcherClient                   network.WatchersClient
+	wafPolicyClient                 network.WebApplicationFirewallPoliciesClient
 
 	// Notification Hubs
 	notificationHubsClient       notificationhubs.Client
@@ -359,8 +389,9 @@ type ArmClient struct {
 	signalRClient signalr.Client
 
 	// Storage
-	storageServiceClient storage.AccountsClient
-	storageUsageClient   storage.UsageClient
+	storageServiceClient            storage.AccountsClient
+	storageUsageClient              storage.UsageClient
+	storageManagementPoliciesClient storageMgmt.ManagementPoliciesClient
 
 	// Stream Analytics
 	streamAnalyticsFunctionsClient       streamanalytics.FunctionsClient
@@ -408,9 +439,11 @@ func (c *ArmClient) configureClient(client *autorest.Client, auth autorest.Autho
 	setUserAgent(client, c.partnerId)
 	client.Authorizer = auth
 	//client.RequestInspector = azure.WithClientID(clientRequestID())
-	client.Sender = azure.BuildSender()
+	client.Sender = c.sender
 	client.SkipResourceProviderRegistration = c.skipProviderRegistration
-	client.PollingDuration = 60 * time.Minute
+	// the Timeouts defined on each resource scope the context used for polling, which takes precedence
+	// over this value - as such this is only a fallback for requests made outside of a resource's CRUD
+	client.PollingDuration = 180 * time.Minute
 }
 
 func setUserAgent(client *autorest.Client, partnerID string) {
@@ -435,7 +468,7 @@ func setUserAgent(client *autorest.Client, partnerID string) {
 
 // getArmClient is a helper method which returns a fully instantiated
 // *ArmClient based on the Config's current settings.
-func getArmClient(c *authentication.Config, skipProviderRegistration bool, partnerId string) (*ArmClient, error) {
+func getArmClient(c *authentication.Config, skipProviderRegistration bool, partnerId string, auxiliaryTenantIds []string, senderOptions azure.SenderOptions) (*ArmClient, error) {
 	env, err := authentication.DetermineEnvironment(c.Environment)
 	if err != nil {
 		return nil, err
@@ -450,6 +483,7 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 		environment:              *env,
 		usingServicePrincipal:    c.AuthenticatedAsAServicePrincipal,
 		skipProviderRegistration: skipProviderRegistration,
+		tracer:                   senderOptions.Tracer,
 	}
 
 	oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, c.TenantID)
@@ -462,15 +496,39 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 		return nil, fmt.Errorf("Unable to configure OAuthConfig for tenant %s", c.TenantID)
 	}
 
-	sender := azure.BuildSender()
+	sender := azure.BuildSenderWithOptions(senderOptions)
+	client.sender = sender
 
 	// Resource Manager endpoints
 	endpoint := env.ResourceManagerEndpoint
-	auth, err := c.GetAuthorizationToken(sender, oauthConfig, env.TokenAudience)
+	primaryAuth, err := c.GetAuthorizationToken(sender, oauthConfig, env.TokenAudience)
 	if err != nil {
 		return nil, err
 	}
 
+	// requests to Resource Manager also include a token for each of the Auxiliary Tenants, which
+	// allows resources in other Tenants to be referenced (for example when peering Virtual Networks)
+	if len(auxiliaryTenantIds) > 0 && !c.AuthenticatedAsAServicePrincipal {
+		return nil, fmt.Errorf("Auxiliary Tenants can only be used when authenticating as a Service Principal")
+	}
+	if len(auxiliaryTenantIds) > azure.MaxAuxiliaryTenants {
+		return nil, fmt.Errorf("At most %d Auxiliary Tenants can be specified, got %d", azure.MaxAuxiliaryTenants, len(auxiliaryTenantIds))
+	}
+	auxiliaryAuths := make([]autorest.Authorizer, 0, len(auxiliaryTenantIds))
+	for _, tenantId := range auxiliaryTenantIds {
+		auxiliaryOAuthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, tenantId)
+		if err != nil {
+			return nil, fmt.Errorf("Error configuring OAuthConfig for Auxiliary Tenant %q: %+v", tenantId, err)
+		}
+
+		auxiliaryAuth, err := c.GetAuthorizationToken(sender, auxiliaryOAuthConfig, env.TokenAudience)
+		if err != nil {
+			return nil, fmt.Errorf("Error obtaining an Authorization Token for Auxiliary Tenant %q: %+v", tenantId, err)
+		}
+		auxiliaryAuths = append(auxiliaryAuths, auxiliaryAuth)
+	}
+	auth := azure.NewAuxiliaryTenantsAuthorizer(primaryAuth, auxiliaryAuths)
+
 	// Graph Endpoints
 	graphEndpoint := env.GraphEndpoint
 	graphAuth, err := c.GetAuthorizationToken(sender, oauthConfig, graphEndpoint)
@@ -488,56 +546,63 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 		return keyVaultSpt, nil
 	})
 
-	client.registerApiManagementServiceClients(endpoint, c.SubscriptionID, auth)
-	client.registerAppInsightsClients(endpoint, c.SubscriptionID, auth)
-	client.registerAutomationClients(endpoint, c.SubscriptionID, auth)
-	client.registerAuthentication(endpoint, graphEndpoint, c.SubscriptionID, c.TenantID, auth, graphAuth)
-	client.registerBatchClients(endpoint, c.SubscriptionID, auth)
-	client.registerCDNClients(endpoint, c.SubscriptionID, auth)
-	client.registerCognitiveServiceClients(endpoint, c.SubscriptionID, auth)
-	client.registerComputeClients(endpoint, c.SubscriptionID, auth)
-	client.registerContainerInstanceClients(endpoint, c.SubscriptionID, auth)
-	client.registerContainerRegistryClients(endpoint, c.SubscriptionID, auth)
-	client.registerContainerServicesClients(endpoint, c.SubscriptionID, auth)
-	client.registerCosmosAccountsClients(endpoint, c.SubscriptionID, auth)
-	client.registerDatabricksClients(endpoint, c.SubscriptionID, auth)
-	client.registerDatabases(endpoint, c.SubscriptionID, auth, sender)
-	client.registerDataFactoryClients(endpoint, c.SubscriptionID, auth)
-	client.registerDataLakeStoreClients(endpoint, c.SubscriptionID, auth)
-	client.registerDeviceClients(endpoint, c.SubscriptionID, auth)
-	client.registerDevSpaceClients(endpoint, c.SubscriptionID, auth)
-	client.registerDevTestClients(endpoint, c.SubscriptionID, auth)
-	client.registerDNSClients(endpoint, c.SubscriptionID, auth)
-	client.registerEventGridClients(endpoint, c.SubscriptionID, auth)
-	client.registerEventHubClients(endpoint, c.SubscriptionID, auth)
-	client.registerHDInsightsClients(endpoint, c.SubscriptionID, auth)
-	client.registerKeyVaultClients(endpoint, c.SubscriptionID, auth, keyVaultAuth)
-	client.registerLogicClients(endpoint, c.SubscriptionID, auth)
-	client.registerMediaServiceClients(endpoint, c.SubscriptionID, auth)
-	client.registerMonitorClients(endpoint, c.SubscriptionID, auth)
-	client.registerNetworkingClients(endpoint, c.SubscriptionID, auth)
-	client.registerNotificationHubsClient(endpoint, c.SubscriptionID, auth)
-	client.registerOperationalInsightsClients(endpoint, c.SubscriptionID, auth)
-	client.registerRecoveryServiceClients(endpoint, c.SubscriptionID, auth)
-	client.registerPolicyClients(endpoint, c.SubscriptionID, auth)
-	client.registerManagementGroupClients(endpoint, auth)
-	client.registerRedisClients(endpoint, c.SubscriptionID, auth)
-	client.registerRelayClients(endpoint, c.SubscriptionID, auth)
-	client.registerResourcesClients(endpoint, c.SubscriptionID, auth)
-	client.registerSearchClients(endpoint, c.SubscriptionID, auth)
-	client.registerSecurityCenterClients(endpoint, c.SubscriptionID, auth)
-	client.registerServiceBusClients(endpoint, c.SubscriptionID, auth)
-	client.registerServiceFabricClients(endpoint, c.SubscriptionID, auth)
-	client.registerSchedulerClients(endpoint, c.SubscriptionID, auth)
-	client.registerSignalRClients(endpoint, c.SubscriptionID, auth)
-	client.registerStorageClients(endpoint, c.SubscriptionID, auth)
-	client.registerStreamAnalyticsClients(endpoint, c.SubscriptionID, auth)
-	client.registerTrafficManagerClients(endpoint, c.SubscriptionID, auth)
-	client.registerWebClients(endpoint, c.SubscriptionID, auth)
+	client.registerClients(endpoint, graphEndpoint, c.SubscriptionID, c.TenantID, auth, graphAuth, keyVaultAuth)
 
 	return &client, nil
 }
 
+// registerClients configures each of the API Clients used by the Provider against the specified endpoints
+func (c *ArmClient) registerClients(endpoint, graphEndpoint, subscriptionId, tenantId string, auth, graphAuth, keyVaultAuth autorest.Authorizer) {
+	c.subscriptions = newSubscriptionClients(endpoint, graphEndpoint, auth, graphAuth, keyVaultAuth)
+
+	c.registerApiManagementServiceClients(endpoint, subscriptionId, auth)
+	c.registerAppInsightsClients(endpoint, subscriptionId, auth)
+	c.registerAutomationClients(endpoint, subscriptionId, auth)
+	c.registerAuthentication(endpoint, graphEndpoint, subscriptionId, tenantId, auth, graphAuth)
+	c.registerBatchClients(endpoint, subscriptionId, auth)
+	c.registerCDNClients(endpoint, subscriptionId, auth)
+	c.registerCognitiveServiceClients(endpoint, subscriptionId, auth)
+	c.registerComputeClients(endpoint, subscriptionId, auth)
+	c.registerContainerInstanceClients(endpoint, subscriptionId, auth)
+	c.registerContainerRegistryClients(endpoint, subscriptionId, auth)
+	c.registerContainerServicesClients(endpoint, subscriptionId, auth)
+	c.registerCosmosAccountsClients(endpoint, subscriptionId, auth)
+	c.registerDatabricksClients(endpoint, subscriptionId, auth)
+	c.registerDatabases(endpoint, subscriptionId, auth, c.sender)
+	c.registerDataFactoryClients(endpoint, subscriptionId, auth)
+	c.registerDataLakeStoreClients(endpoint, subscriptionId, auth)
+	c.registerDeviceClients(endpoint, subscriptionId, auth)
+	c.registerDevSpaceClients(endpoint, subscriptionId, auth)
+	c.registerDevTestClients(endpoint, subscriptionId, auth)
+	c.registerDNSClients(endpoint, subscriptionId, auth)
+	c.registerEventGridClients(endpoint, subscriptionId, auth)
+	c.registerEventHubClients(endpoint, subscriptionId, auth)
+	c.registerHDInsightsClients(endpoint, subscriptionId, auth)
+	c.registerKeyVaultClients(endpoint, subscriptionId, auth, keyVaultAuth)
+	c.registerLogicClients(endpoint, subscriptionId, auth)
+	c.registerMediaServiceClients(endpoint, subscriptionId, auth)
+	c.registerMonitorClients(endpoint, subscriptionId, auth)
+	c.registerNetworkingClients(endpoint, subscriptionId, auth)
+	c.registerNotificationHubsClient(endpoint, subscriptionId, auth)
+	c.registerOperationalInsightsClients(endpoint, subscriptionId, auth)
+	c.registerRecoveryServiceClients(endpoint, subscriptionId, auth)
+	c.registerPolicyClients(endpoint, subscriptionId, auth)
+	c.registerManagementGroupClients(endpoint, auth)
+	c.registerRedisClients(endpoint, subscriptionId, auth)
+	c.registerRelayClients(endpoint, subscriptionId, auth)
+	c.registerResourcesClients(endpoint, subscriptionId, auth)
+	c.registerSearchClients(endpoint, subscriptionId, auth)
+	c.registerSecurityCenterClients(endpoint, subscriptionId, auth)
+	c.registerServiceBusClients(endpoint, subscriptionId, auth)
+	c.registerServiceFabricClients(endpoint, subscriptionId, auth)
+	c.registerSchedulerClients(endpoint, subscriptionId, auth)
+	c.registerSignalRClients(endpoint, subscriptionId, auth)
+	c.registerStorageClients(endpoint, subscriptionId, auth)
+	c.registerStreamAnalyticsClients(endpoint, subscriptionId, auth)
+	c.registerTrafficManagerClients(endpoint, subscriptionId, auth)
+	c.registerWebClients(endpoint, subscriptionId, auth)
+}
+
 func (c *ArmClient) registerApiManagementServiceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
 	apisClient := apimanagement.NewAPIClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&apisClient.Client, auth)
@@ -789,6 +854,14 @@ func (c *ArmClient) registerComputeClients(endpoint, subscriptionId string, auth
 	c.configureClient(&scaleSetsClient.Client, auth)
 	c.vmScaleSetClient = scaleSetsClient
 
+	scaleSetRollingUpgradesClient := compute.NewVirtualMachineScaleSetRollingUpgradesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&scaleSetRollingUpgradesClient.Client, auth)
+	c.vmScaleSetRollingUpgradesClient = scaleSetRollingUpgradesClient
+
+	scaleSetVMsClient := compute.NewVirtualMachineScaleSetVMsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&scaleSetVMsClient.Client, auth)
+	c.vmScaleSetVMsClient = scaleSetVMsClient
+
 	virtualMachinesClient := compute.NewVirtualMachinesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&virtualMachinesClient.Client, auth)
 	c.vmClient = virtualMachinesClient
@@ -833,6 +906,10 @@ func (c *ArmClient) registerContainerServicesClients(endpoint, subscriptionId st
 	kubernetesClustersClient := containerservice.NewManagedClustersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&kubernetesClustersClient.Client, auth)
 	c.kubernetesClustersClient = kubernetesClustersClient
+
+	kubernetesClusterNodePoolsClient := containerservice.NewAgentPoolsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&kubernetesClusterNodePoolsClient.Client, auth)
+	c.kubernetesClusterNodePoolsClient = kubernetesClusterNodePoolsClient
 }
 
 func (c *ArmClient) registerDatabricksClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -1007,6 +1084,18 @@ func (c *ArmClient) registerDNSClients(endpoint, subscriptionId string, auth aut
 	zo := dns.NewZonesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&zo.Client, auth)
 	c.zonesClient = zo
+
+	pdn := privatedns.NewRecordSetsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&pdn.Client, auth)
+	c.privateDnsClient = pdn
+
+	pzo := privatedns.NewPrivateZonesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&pzo.Client, auth)
+	c.privateDnsZonesClient = pzo
+
+	vnl := privatedns.NewVirtualNetworkLinksClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&vnl.Client, auth)
+	c.privateDnsVirtualNetworkLinksClient = vnl
 }
 
 func (c *ArmClient) registerEventGridClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -1154,6 +1243,10 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&gatewayConnectionsClient.Client, auth)
 	c.vnetGatewayConnectionsClient = gatewayConnectionsClient
 
+	natGatewaysClient := network.NewNatGatewaysClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&natGatewaysClient.Client, auth)
+	c.natGatewayClient = natGatewaysClient
+
 	netProfileClient := network.NewProfilesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&netProfileClient.Client, auth)
 	c.netProfileClient = netProfileClient
@@ -1205,6 +1298,10 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	watchersClient := network.NewWatchersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&watchersClient.Client, auth)
 	c.watcherClient = watchersClient
+
+	webApplicationFirewallPoliciesClient := network.NewWebApplicationFirewallPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&webApplicationFirewallPoliciesClient.Client, auth)
+	c.wafPolicyClient = webApplicationFirewallPoliciesClient
 }
 
 func (c *ArmClient) registerNotificationHubsClient(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -1370,6 +1467,10 @@ func (c *ArmClient) registerStorageClients(endpoint, subscriptionId string, auth
 	usageClient := storage.NewUsageClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&usageClient.Client, auth)
 	c.storageUsageClient = usageClient
+
+	managementPoliciesClient := storageMgmt.NewManagementPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&managementPoliciesClient.Client, auth)
+	c.storageManagementPoliciesClient = managementPoliciesClient
 }
 
 func (c *ArmClient) registerStreamAnalyticsClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -1,9 +1,7 @@
 package azurerm
 
 import (
-	"fmt"
 	"log"
-	"regexp"
 	"sort"
 	"strings"
 
@@ -11,15 +9,6 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-func validateAzureRMDataFactoryLinkedServiceDatasetName(v interface{}, k string) (warnings []string, errors []error) {
-	value := v.(string)
-	if regexp.MustCompile(`^[-.+?/<>*%&:\\]+$`).MatchString(value) {
-		errors = append(errors, fmt.Errorf("any of '-' '.', '+', '?', '/', '<', '>', '*', '%%', '&', ':', '\\', are not allowed in %q: %q", k, value))
-	}
-
-	return warnings, errors
-}
-
 func expandDataFactoryLinkedServiceIntegrationRuntime(integrationRuntimeName string) *datafactory.IntegrationRuntimeReference {
 	typeString := "IntegrationRuntimeReference"
 
@@ -3,15 +3,22 @@ package azurerm
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2018-03-01/insights"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 )
 
 func dataSourceArmMonitorDiagnosticCategories() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceArmMonitorDiagnosticCategoriesRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
 				Type:         schema.TypeString,
@@ -38,7 +45,8 @@ func dataSourceArmMonitorDiagnosticCategories() *schema.Resource {
 
 func dataSourceArmMonitorDiagnosticCategoriesRead(d *schema.ResourceData, meta interface{}) error {
 	categoriesClient := meta.(*ArmClient).monitorDiagnosticSettingsCategoryClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	actualResourceId := d.Get("resource_id").(string)
 	// trim off the leading `/` since the CheckExistenceByID / List methods don't expect it
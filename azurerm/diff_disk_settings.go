@@ -0,0 +1,73 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func diffDiskSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		// the OS Disk can't be switched between a Managed Disk and an Ephemeral Disk
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"option": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.Local),
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func expandDiffDiskSettings(input []interface{}) *compute.DiffDiskSettings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	settings := input[0].(map[string]interface{})
+	return &compute.DiffDiskSettings{
+		Option: compute.DiffDiskOptions(settings["option"].(string)),
+	}
+}
+
+func flattenDiffDiskSettings(input *compute.DiffDiskSettings) []interface{} {
+	if input == nil || input.Option == "" {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"option": string(input.Option),
+		},
+	}
+}
+
+// validateDiffDiskSettings validates that an Ephemeral OS Disk is a Managed Disk using `ReadOnly` caching, which are
+// requirements of the API that otherwise only fail once the Virtual Machine is being provisioned
+func validateDiffDiskSettings(settings *compute.DiffDiskSettings, caching string, managed bool) error {
+	if settings == nil {
+		return nil
+	}
+
+	if !managed {
+		return fmt.Errorf("[ERROR] `diff_disk_settings` can only be specified for a Managed Disk")
+	}
+
+	if !strings.EqualFold(caching, string(compute.CachingTypesReadOnly)) {
+		return fmt.Errorf("[ERROR] `caching` must be set to `ReadOnly` when `diff_disk_settings` is specified - got %q", caching)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package azurerm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+)
+
+func TestDiffDiskSettings_roundTrip(t *testing.T) {
+	if v := expandDiffDiskSettings([]interface{}{}); v != nil {
+		t.Fatalf("Expected no Diff Disk Settings but got %+v", v)
+	}
+	if v := flattenDiffDiskSettings(nil); len(v) != 0 {
+		t.Fatalf("Expected no `diff_disk_settings` but got %+v", v)
+	}
+
+	input := []interface{}{
+		map[string]interface{}{
+			"option": "Local",
+		},
+	}
+
+	settings := expandDiffDiskSettings(input)
+	if settings == nil || settings.Option != compute.Local {
+		t.Fatalf("Expected the Option to be %q but got %+v", compute.Local, settings)
+	}
+
+	if output := flattenDiffDiskSettings(settings); !reflect.DeepEqual(input, output) {
+		t.Fatalf("Expected %+v but got %+v", input, output)
+	}
+}
+
+func TestValidateDiffDiskSettings(t *testing.T) {
+	local := &compute.DiffDiskSettings{
+		Option: compute.Local,
+	}
+
+	testData := []struct {
+		Name     string
+		Settings *compute.DiffDiskSettings
+		Caching  string
+		Managed  bool
+		Valid    bool
+	}{
+		{
+			Name:     "Not Ephemeral",
+			Settings: nil,
+			Caching:  "ReadWrite",
+			Managed:  false,
+			Valid:    true,
+		},
+		{
+			Name:     "Ephemeral",
+			Settings: local,
+			Caching:  "ReadOnly",
+			Managed:  true,
+			Valid:    true,
+		},
+		{
+			Name:     "Ephemeral Mixed Case Caching",
+			Settings: local,
+			Caching:  "readonly",
+			Managed:  true,
+			Valid:    true,
+		},
+		{
+			Name:     "Ephemeral ReadWrite Caching",
+			Settings: local,
+			Caching:  "ReadWrite",
+			Managed:  true,
+			Valid:    false,
+		},
+		{
+			Name:     "Ephemeral No Caching",
+			Settings: local,
+			Caching:  "",
+			Managed:  true,
+			Valid:    false,
+		},
+		{
+			Name:     "Ephemeral Unmanaged",
+			Settings: local,
+			Caching:  "ReadOnly",
+			Managed:  false,
+			Valid:    false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := validateDiffDiskSettings(v.Settings, v.Caching, v.Managed)
+		if valid := err == nil; valid != v.Valid {
+			t.Fatalf("Expected %t but got %t: %v", v.Valid, valid, err)
+		}
+	}
+}
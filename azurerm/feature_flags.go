@@ -3,11 +3,95 @@ package azurerm
 import (
 	"os"
 	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// NOTE: we'll need to add an infobox to MySQL|PostgreSQL Configuration when this goes live
-// since these resources can't support import
-// in addition the virtual resources will need adjusting
+// features contains the optional behaviours of the Provider, which are configured using the `features` block
+type features struct {
+	// requireResourcesToBeImported means that a resource which already exists must be imported into the
+	// State to be managed by Terraform, rather than being silently adopted during the Create
+	requireResourcesToBeImported bool
+
+	keyVault keyVaultFeatures
+}
+
+// keyVaultFeatures controls how soft-deleted Key Vaults (and the Certificates, Keys and Secrets within them) are handled
+type keyVaultFeatures struct {
+	// purgeSoftDeleteOnDestroy means that a Key Vault, Certificate, Key or Secret which is soft-deleted when it's
+	// destroyed is also purged, so that it can be re-created with the same name
+	purgeSoftDeleteOnDestroy bool
+
+	// recoverSoftDeletedKeyVaults means that a soft-deleted Key Vault, Certificate, Key or Secret with the same name
+	// is recovered during the Create, rather than the Create failing
+	recoverSoftDeletedKeyVaults bool
+}
+
+func schemaFeatures() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"require_import": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"key_vault": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"purge_soft_delete_on_destroy": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+
+							"recover_soft_deleted_key_vaults": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandFeatures(input []interface{}) features {
+	// when the `features` block isn't specified this can be enabled using the `ARM_PROVIDER_STRICT` Environment Variable
+	if len(input) == 0 || input[0] == nil {
+		return features{
+			requireResourcesToBeImported: requireResourcesToBeImportedFromEnvironment(),
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	return features{
+		requireResourcesToBeImported: v["require_import"].(bool),
+		keyVault:                     expandKeyVaultFeatures(v["key_vault"].([]interface{})),
+	}
+}
+
+func expandKeyVaultFeatures(input []interface{}) keyVaultFeatures {
+	if len(input) == 0 || input[0] == nil {
+		return keyVaultFeatures{}
+	}
+
+	v := input[0].(map[string]interface{})
+	return keyVaultFeatures{
+		purgeSoftDeleteOnDestroy:    v["purge_soft_delete_on_destroy"].(bool),
+		recoverSoftDeletedKeyVaults: v["recover_soft_deleted_key_vaults"].(bool),
+	}
+}
 
-// This file contains feature flags for functionality which will prove more challenging to implement en-mass
-var requireResourcesToBeImported = strings.EqualFold(os.Getenv("ARM_PROVIDER_STRICT"), "true")
+func requireResourcesToBeImportedFromEnvironment() bool {
+	return strings.EqualFold(os.Getenv("ARM_PROVIDER_STRICT"), "true")
+}
@@ -0,0 +1,129 @@
+package azurerm
+
+import (
+	"os"
+	"testing"
+)
+
+func TestExpandFeatures(t *testing.T) {
+	testData := []struct {
+		Name        string
+		Input       []interface{}
+		Environment string
+		Expected    bool
+	}{
+		{
+			Name:     "Not Specified",
+			Input:    []interface{}{},
+			Expected: false,
+		},
+		{
+			Name:        "Not Specified with the Environment Variable",
+			Input:       []interface{}{},
+			Environment: "true",
+			Expected:    true,
+		},
+		{
+			Name: "Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"require_import": true,
+					"key_vault":      []interface{}{},
+				},
+			},
+			Expected: true,
+		},
+		{
+			Name: "Disabled overrides the Environment Variable",
+			Input: []interface{}{
+				map[string]interface{}{
+					"require_import": false,
+					"key_vault":      []interface{}{},
+				},
+			},
+			Environment: "true",
+			Expected:    false,
+		},
+	}
+
+	original := os.Getenv("ARM_PROVIDER_STRICT")
+	defer os.Setenv("ARM_PROVIDER_STRICT", original)
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		os.Setenv("ARM_PROVIDER_STRICT", v.Environment)
+		actual := expandFeatures(v.Input)
+		if actual.requireResourcesToBeImported != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual.requireResourcesToBeImported)
+		}
+	}
+}
+
+func TestExpandFeaturesKeyVault(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected keyVaultFeatures
+	}{
+		{
+			Name:     "Not Specified",
+			Input:    []interface{}{},
+			Expected: keyVaultFeatures{},
+		},
+		{
+			Name: "Key Vault block not specified",
+			Input: []interface{}{
+				map[string]interface{}{
+					"require_import": false,
+					"key_vault":      []interface{}{},
+				},
+			},
+			Expected: keyVaultFeatures{},
+		},
+		{
+			Name: "Purge and Recover",
+			Input: []interface{}{
+				map[string]interface{}{
+					"require_import": false,
+					"key_vault": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy":    true,
+							"recover_soft_deleted_key_vaults": true,
+						},
+					},
+				},
+			},
+			Expected: keyVaultFeatures{
+				purgeSoftDeleteOnDestroy:    true,
+				recoverSoftDeletedKeyVaults: true,
+			},
+		},
+		{
+			Name: "Recover only",
+			Input: []interface{}{
+				map[string]interface{}{
+					"require_import": false,
+					"key_vault": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy":    false,
+							"recover_soft_deleted_key_vaults": true,
+						},
+					},
+				},
+			},
+			Expected: keyVaultFeatures{
+				recoverSoftDeletedKeyVaults: true,
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := expandFeatures(v.Input)
+		if actual.keyVault != v.Expected {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual.keyVault)
+		}
+	}
+}
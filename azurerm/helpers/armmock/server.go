@@ -0,0 +1,287 @@
+package armmock
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+// Server is an in-process fake of the Azure Resource Manager API, which stores Resources in memory.
+//
+// It supports creating/updating (PUT), retrieving (GET) and deleting (DELETE) Resources, in addition
+// to listing the direct children of a Resource - where PUT and DELETE requests are modelled as
+// Long Running Operations which complete after `PollsBeforeCompletion` polls.
+type Server struct {
+	// PollsBeforeCompletion is the number of times an Operation must be polled before it Succeeds
+	PollsBeforeCompletion int
+
+	// URL is the Base URL of this Server, which should be used as the Resource Manager Endpoint
+	URL string
+
+	lock       sync.Mutex
+	server     *httptest.Server
+	resources  map[string]map[string]interface{}
+	operations map[string]int
+	requests   []string
+	operation  int
+}
+
+// NewServer starts a new fake Resource Manager API, which must be closed using Close
+func NewServer() *Server {
+	s := &Server{
+		PollsBeforeCompletion: 1,
+		resources:             make(map[string]map[string]interface{}),
+		operations:            make(map[string]int),
+		requests:              make([]string, 0),
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.handle))
+	s.URL = s.server.URL
+	return s
+}
+
+// Close shuts down the Server
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Transport returns a http.RoundTripper which sends requests to this Server
+func (s *Server) Transport() http.RoundTripper {
+	return s.server.Client().Transport
+}
+
+// Requests returns the Method and Path of each request received by this Server, e.g. `GET /subscriptions/...`
+func (s *Server) Requests() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return append([]string{}, s.requests...)
+}
+
+// Resource returns the Resource stored at the specified ID, if it exists
+func (s *Server) Resource(id string) (map[string]interface{}, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	resource, exists := s.resources[strings.ToLower(id)]
+	return resource, exists
+}
+
+// SetResource stores the Resource at the specified ID - which allows tests to seed existing Resources
+func (s *Server) SetResource(id string, resource map[string]interface{}) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.resources[strings.ToLower(id)] = populateResource(id, resource)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	s.requests = append(s.requests, fmt.Sprintf("%s %s", r.Method, path))
+
+	if strings.HasPrefix(path, "/operations/") {
+		s.handleOperation(w, strings.TrimPrefix(path, "/operations/"))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		s.handleGet(w, path)
+	case http.MethodPut:
+		s.handlePut(w, r, path)
+	case http.MethodDelete:
+		s.handleDelete(w, r, path)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("The fake Resource Manager API doesn't support %s requests", r.Method))
+	}
+}
+
+func (s *Server) handleGet(w http.ResponseWriter, path string) {
+	if resource, exists := s.resources[strings.ToLower(path)]; exists {
+		writeJSON(w, http.StatusOK, resource)
+		return
+	}
+
+	// otherwise this may be a List of the direct children of a Resource, e.g. `.../virtualNetworks/example/subnets`
+	children := make([]interface{}, 0)
+	prefix := strings.ToLower(path) + "/"
+	for id, resource := range s.resources {
+		if strings.HasPrefix(id, prefix) && !strings.Contains(strings.TrimPrefix(id, prefix), "/") {
+			children = append(children, resource)
+		}
+	}
+
+	if len(children) > 0 || segmentCount(path)%2 != 0 {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"value": children,
+		})
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource %q was not found.", path))
+}
+
+func (s *Server) handlePut(w http.ResponseWriter, r *http.Request, path string) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", fmt.Sprintf("Error reading the Request Body: %+v", err))
+		return
+	}
+
+	resource := make(map[string]interface{})
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &resource); err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidRequestContent", fmt.Sprintf("Error parsing the Request Body: %+v", err))
+			return
+		}
+	}
+
+	key := strings.ToLower(path)
+	_, exists := s.resources[key]
+	resource = populateResource(path, resource)
+	s.resources[key] = resource
+
+	statusCode := http.StatusCreated
+	if exists {
+		statusCode = http.StatusOK
+	}
+
+	if s.PollsBeforeCompletion > 0 {
+		// the Resource is returned as in-progress, so that the Operation has to be polled to completion
+		s.startOperation(w, r)
+		writeJSON(w, statusCode, withProvisioningState(resource, "Accepted"))
+		return
+	}
+
+	writeJSON(w, statusCode, resource)
+}
+
+func (s *Server) handleDelete(w http.ResponseWriter, r *http.Request, path string) {
+	key := strings.ToLower(path)
+	if _, exists := s.resources[key]; !exists {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// deleting a Resource also deletes any nested Resources
+	for id := range s.resources {
+		if id == key || strings.HasPrefix(id, key+"/") {
+			delete(s.resources, id)
+		}
+	}
+
+	if s.PollsBeforeCompletion > 0 {
+		s.startOperation(w, r)
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) handleOperation(w http.ResponseWriter, operationId string) {
+	remaining, exists := s.operations[operationId]
+	if !exists {
+		writeError(w, http.StatusNotFound, "OperationNotFound", fmt.Sprintf("The Operation %q was not found.", operationId))
+		return
+	}
+
+	status := "Succeeded"
+	if remaining > 1 {
+		status = "InProgress"
+		w.Header().Set("Retry-After", "0")
+	}
+	s.operations[operationId] = remaining - 1
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"name":   operationId,
+		"status": status,
+	})
+}
+
+// startOperation registers a new Long Running Operation and sets the Headers used to poll it
+func (s *Server) startOperation(w http.ResponseWriter, r *http.Request) {
+	s.operation++
+	operationId := fmt.Sprintf("operation%d", s.operation)
+	s.operations[operationId] = s.PollsBeforeCompletion
+
+	operationUrl := fmt.Sprintf("%s/operations/%s?%s", s.URL, operationId, r.URL.RawQuery)
+	w.Header().Set("Azure-AsyncOperation", operationUrl)
+	w.Header().Set("Location", operationUrl)
+	w.Header().Set("Retry-After", "0")
+}
+
+// populateResource sets the computed fields which Resource Manager returns for every Resource
+func populateResource(id string, resource map[string]interface{}) map[string]interface{} {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+
+	resourceType := ""
+	for i := 0; i < len(segments)-1; i += 2 {
+		switch {
+		case strings.EqualFold(segments[i], "providers"):
+			resourceType = segments[i+1]
+		case resourceType != "":
+			resourceType += "/" + segments[i]
+		}
+	}
+	if resourceType == "" && len(segments) > 1 {
+		resourceType = segments[len(segments)-2]
+	}
+
+	resource["id"] = id
+	resource["name"] = segments[len(segments)-1]
+	resource["type"] = resourceType
+
+	properties, ok := resource["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+	}
+	properties["provisioningState"] = "Succeeded"
+	resource["properties"] = properties
+
+	return resource
+}
+
+// withProvisioningState returns a copy of the Resource with the specified Provisioning State
+func withProvisioningState(resource map[string]interface{}, state string) map[string]interface{} {
+	out := make(map[string]interface{})
+	for k, v := range resource {
+		out[k] = v
+	}
+
+	properties := make(map[string]interface{})
+	if existing, ok := resource["properties"].(map[string]interface{}); ok {
+		for k, v := range existing {
+			properties[k] = v
+		}
+	}
+	properties["provisioningState"] = state
+	out["properties"] = properties
+
+	return out
+}
+
+func segmentCount(path string) int {
+	return len(strings.Split(strings.Trim(path, "/"), "/"))
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(body) //nolint: errcheck
+}
+
+func writeError(w http.ResponseWriter, statusCode int, code, message string) {
+	writeJSON(w, statusCode, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
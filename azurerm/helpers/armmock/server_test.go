@@ -0,0 +1,97 @@
+package armmock
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const testVirtualNetworkId = "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1"
+
+func TestServerLifecycle(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.PollsBeforeCompletion = 2
+
+	client := &http.Client{Transport: server.Transport()}
+
+	// retrieving a Resource which doesn't exist
+	resp, body := testDoRequest(t, client, http.MethodGet, server.URL+testVirtualNetworkId, "")
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("Expected a 404 but got %d", resp.StatusCode)
+	}
+	if code := body["error"].(map[string]interface{})["code"]; code != "ResourceNotFound" {
+		t.Fatalf("Expected the error code `ResourceNotFound` but got %q", code)
+	}
+
+	// creating the Resource returns a Long Running Operation
+	resp, body = testDoRequest(t, client, http.MethodPut, server.URL+testVirtualNetworkId, `{"location":"westeurope"}`)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected a 201 but got %d", resp.StatusCode)
+	}
+	if state := body["properties"].(map[string]interface{})["provisioningState"]; state != "Accepted" {
+		t.Fatalf("Expected the Provisioning State to be `Accepted` but got %q", state)
+	}
+
+	operationUrl := resp.Header.Get("Azure-AsyncOperation")
+	for i, expected := range []string{"InProgress", "Succeeded"} {
+		_, body = testDoRequest(t, client, http.MethodGet, operationUrl, "")
+		if status := body["status"]; status != expected {
+			t.Fatalf("Expected poll %d to be %q but got %q", i, expected, status)
+		}
+	}
+
+	// the computed fields are populated, and the path is case-insensitive
+	_, body = testDoRequest(t, client, http.MethodGet, server.URL+strings.ToLower(testVirtualNetworkId), "")
+	if body["name"] != "network1" || body["type"] != "Microsoft.Network/virtualNetworks" || body["location"] != "westeurope" {
+		t.Fatalf("Unexpected Resource returned: %+v", body)
+	}
+
+	// nested Resources can be listed
+	server.SetResource(testVirtualNetworkId+"/subnets/subnet1", map[string]interface{}{})
+	_, body = testDoRequest(t, client, http.MethodGet, server.URL+testVirtualNetworkId+"/subnets", "")
+	if values := body["value"].([]interface{}); len(values) != 1 {
+		t.Fatalf("Expected 1 Subnet to be listed but got %d", len(values))
+	}
+
+	// deleting the Resource also deletes nested Resources
+	resp, _ = testDoRequest(t, client, http.MethodDelete, server.URL+testVirtualNetworkId, "")
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("Expected a 202 but got %d", resp.StatusCode)
+	}
+	if _, exists := server.Resource(testVirtualNetworkId + "/subnets/subnet1"); exists {
+		t.Fatalf("Expected the nested Subnet to be deleted")
+	}
+
+	resp, _ = testDoRequest(t, client, http.MethodDelete, server.URL+testVirtualNetworkId, "")
+	if resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("Expected a 204 but got %d", resp.StatusCode)
+	}
+
+	if requests := server.Requests(); len(requests) != 8 {
+		t.Fatalf("Expected 8 requests but got %d: %+v", len(requests), requests)
+	}
+}
+
+func testDoRequest(t *testing.T, client *http.Client, method, url, body string) (*http.Response, map[string]interface{}) {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("Error building request: %+v", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Error sending %s request to %q: %+v", method, url, err)
+	}
+	defer resp.Body.Close()
+
+	out := make(map[string]interface{})
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusNoContent {
+		if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+			t.Fatalf("Error decoding response for %s %q: %+v", method, url, err)
+		}
+	}
+
+	return resp, out
+}
@@ -0,0 +1,69 @@
+package azure
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// AuxiliaryAuthorizationHeader is the header used to send the tokens for the Auxiliary Tenants to Resource Manager,
+// which is needed when a request references resources in other Tenants (for example peering Virtual Networks)
+const AuxiliaryAuthorizationHeader = "x-ms-authorization-auxiliary"
+
+// MaxAuxiliaryTenants is the maximum number of Auxiliary Tenants supported by Resource Manager
+const MaxAuxiliaryTenants = 3
+
+type auxiliaryTenantsAuthorizer struct {
+	primary   autorest.Authorizer
+	auxiliary []autorest.Authorizer
+}
+
+// NewAuxiliaryTenantsAuthorizer returns an Authorizer which authorizes requests using the `primary` Authorizer, and
+// sends a token from each of the `auxiliary` Authorizers (one per Auxiliary Tenant) in the auxiliary authorization header
+func NewAuxiliaryTenantsAuthorizer(primary autorest.Authorizer, auxiliary []autorest.Authorizer) autorest.Authorizer {
+	if len(auxiliary) == 0 {
+		return primary
+	}
+
+	return auxiliaryTenantsAuthorizer{
+		primary:   primary,
+		auxiliary: auxiliary,
+	}
+}
+
+func (a auxiliaryTenantsAuthorizer) WithAuthorization() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := a.primary.WithAuthorization()(p).Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			tokens := make([]string, 0, len(a.auxiliary))
+			for i, authorizer := range a.auxiliary {
+				// the token is obtained (and refreshed if necessary) by authorizing an empty request to the same URL
+				tokenRequest := (&http.Request{
+					URL:    r.URL,
+					Header: make(http.Header),
+				}).WithContext(r.Context())
+
+				tokenRequest, err := authorizer.WithAuthorization()(autorest.CreatePreparer()).Prepare(tokenRequest)
+				if err != nil {
+					return r, fmt.Errorf("Error obtaining a token for Auxiliary Tenant %d: %+v", i, err)
+				}
+
+				token := tokenRequest.Header.Get("Authorization")
+				if token == "" {
+					return r, fmt.Errorf("Error obtaining a token for Auxiliary Tenant %d: no token was returned", i)
+				}
+
+				tokens = append(tokens, token)
+			}
+
+			r.Header.Set(AuxiliaryAuthorizationHeader, strings.Join(tokens, ", "))
+			return r, nil
+		})
+	}
+}
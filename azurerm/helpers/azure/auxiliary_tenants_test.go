@@ -0,0 +1,52 @@
+package azure
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestAuxiliaryTenantsAuthorizer(t *testing.T) {
+	primary := autorest.NewAPIKeyAuthorizerWithHeaders(map[string]interface{}{
+		"Authorization": "Bearer primary",
+	})
+	auxiliary := []autorest.Authorizer{
+		autorest.NewAPIKeyAuthorizerWithHeaders(map[string]interface{}{
+			"Authorization": "Bearer first",
+		}),
+		autorest.NewAPIKeyAuthorizerWithHeaders(map[string]interface{}{
+			"Authorization": "Bearer second",
+		}),
+	}
+
+	request, err := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000", nil)
+	if err != nil {
+		t.Fatalf("Error building request: %+v", err)
+	}
+
+	authorizer := NewAuxiliaryTenantsAuthorizer(primary, auxiliary)
+	request, err = autorest.Prepare(request, authorizer.WithAuthorization())
+	if err != nil {
+		t.Fatalf("Error authorizing request: %+v", err)
+	}
+
+	if actual := request.Header.Get("Authorization"); actual != "Bearer primary" {
+		t.Fatalf("Expected the Authorization header to be %q but got %q", "Bearer primary", actual)
+	}
+
+	expected := "Bearer first, Bearer second"
+	if actual := request.Header.Get(AuxiliaryAuthorizationHeader); actual != expected {
+		t.Fatalf("Expected the %s header to be %q but got %q", AuxiliaryAuthorizationHeader, expected, actual)
+	}
+}
+
+func TestAuxiliaryTenantsAuthorizerWithoutAuxiliaryTenants(t *testing.T) {
+	primary := autorest.NewAPIKeyAuthorizerWithHeaders(map[string]interface{}{
+		"Authorization": "Bearer primary",
+	})
+
+	if authorizer := NewAuxiliaryTenantsAuthorizer(primary, nil); authorizer != primary {
+		t.Fatalf("Expected the primary Authorizer to be returned when there are no Auxiliary Tenants")
+	}
+}
@@ -54,7 +54,7 @@ func ParseAzureResourceID(id string) (*ResourceID, error) {
 
 		// Catch the subscriptionID before it can be overwritten by another "subscriptions"
 		// value in the ID which is the case for the Service Bus subscription resource
-		if key == "subscriptions" && subscriptionID == "" {
+		if strings.EqualFold(key, "subscriptions") && subscriptionID == "" {
 			subscriptionID = value
 		} else {
 			componentMap[key] = value
@@ -71,26 +71,42 @@ func ParseAzureResourceID(id string) (*ResourceID, error) {
 		return nil, fmt.Errorf("No subscription ID found in: %q", path)
 	}
 
-	if resourceGroup, ok := componentMap["resourceGroups"]; ok {
+	// Some Azure APIs are weird and provide things in lower case, so the
+	// Resource Group and Provider segments are matched case-insensitively
+	if resourceGroup, err := idObj.PopSegment("resourceGroups"); err == nil {
 		idObj.ResourceGroup = resourceGroup
-		delete(componentMap, "resourceGroups")
 	} else {
-		// Some Azure APIs are weird and provide things in lower case...
-		// However it's not clear whether the casing of other elements in the URI
-		// matter, so we explicitly look for that case here.
-		if resourceGroup, ok := componentMap["resourcegroups"]; ok {
-			idObj.ResourceGroup = resourceGroup
-			delete(componentMap, "resourcegroups")
-		} else {
-			return nil, fmt.Errorf("No resource group name found in: %q", path)
-		}
+		return nil, fmt.Errorf("No resource group name found in: %q", path)
 	}
 
 	// It is OK not to have a provider in the case of a resource group
-	if provider, ok := componentMap["providers"]; ok {
+	if provider, err := idObj.PopSegment("providers"); err == nil {
 		idObj.Provider = provider
-		delete(componentMap, "providers")
 	}
 
 	return idObj, nil
 }
+
+// PopSegment retrieves a segment from the Path and returns it, removing it from the Path
+// so that any unexpected segments can be detected. Since some Azure APIs return IDs
+// with inconsistent casing, the key is matched case-insensitively.
+func (id *ResourceID) PopSegment(name string) (string, error) {
+	for key, value := range id.Path {
+		if strings.EqualFold(key, name) {
+			delete(id.Path, key)
+			return value, nil
+		}
+	}
+
+	return "", fmt.Errorf("ID was missing the `%s` element", name)
+}
+
+// ValidateNoEmptySegments validates that all of the segments in the Path have been
+// popped, which ensures that the ID didn't contain more segments than expected
+func (id *ResourceID) ValidateNoEmptySegments(sourceId string) error {
+	if len(id.Path) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("ID contained more segments than required: %q, %v", sourceId, id.Path)
+}
@@ -113,6 +113,18 @@ func TestParseAzureResourceID(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"/SUBSCRIPTIONS/34ca515c-4629-458e-bf7c-738d77e0d0ea/RESOURCEGROUPS/acceptanceTestResourceGroup1/Providers/Microsoft.Cdn/profiles/acceptanceTestCdnProfile1",
+			&ResourceID{
+				SubscriptionID: "34ca515c-4629-458e-bf7c-738d77e0d0ea",
+				ResourceGroup:  "acceptanceTestResourceGroup1",
+				Provider:       "Microsoft.Cdn",
+				Path: map[string]string{
+					"profiles": "acceptanceTestCdnProfile1",
+				},
+			},
+			false,
+		},
 		{
 			"/subscriptions/34ca515c-4629-458e-bf7c-738d77e0d0ea/resourceGroups/testGroup1/providers/Microsoft.ServiceBus/namespaces/testNamespace1/topics/testTopic1/subscriptions/testSubscription1",
 			&ResourceID{
@@ -156,3 +168,38 @@ func TestParseAzureResourceID(t *testing.T) {
 		}
 	}
 }
+
+func TestResourceIDPopSegment(t *testing.T) {
+	id, err := ParseAzureResourceID("/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if err := id.ValidateNoEmptySegments("example"); err == nil {
+		t.Fatalf("Expected an error when segments remain but didn't get one")
+	}
+
+	networkName, err := id.PopSegment("VIRTUALNETWORKS")
+	if err != nil {
+		t.Fatalf("Unexpected error popping `VIRTUALNETWORKS`: %s", err)
+	}
+	if networkName != "network1" {
+		t.Fatalf("Expected `network1` but got %q", networkName)
+	}
+
+	if _, err := id.PopSegment("virtualNetworks"); err == nil {
+		t.Fatalf("Expected an error popping `virtualNetworks` a second time but didn't get one")
+	}
+
+	subnetName, err := id.PopSegment("subnets")
+	if err != nil {
+		t.Fatalf("Unexpected error popping `subnets`: %s", err)
+	}
+	if subnetName != "subnet1" {
+		t.Fatalf("Expected `subnet1` but got %q", subnetName)
+	}
+
+	if err := id.ValidateNoEmptySegments("example"); err != nil {
+		t.Fatalf("Unexpected error when no segments remain: %s", err)
+	}
+}
@@ -1,57 +1,360 @@
 package azure
 
 import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
 	"log"
+	"math"
 	"net/http"
-	"net/http/httputil"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tracing"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+// SenderOptions configures the retry and throttling behaviour of the Sender returned from BuildSender
+type SenderOptions struct {
+	// MaxRetries is the number of times a throttled (429) or failed (5xx) request is retried
+	MaxRetries int
+
+	// MaxConcurrentRequests is the number of requests which can be in-flight against a
+	// single Subscription at any one time - where 0 means there's no limit
+	MaxConcurrentRequests int
+
+	// Transport, when set, is used to send requests in place of the default HTTP Transport - which
+	// allows requests to be recorded, replayed or sent to a fake Resource Manager API in tests
+	Transport http.RoundTripper
+
+	// Tracer, when set, records a Span for each request made to Azure
+	Tracer *tracing.Tracer
+}
+
+const maxRetryDelay = 2 * time.Minute
+
+var subscriptionIdFromPathRegex = regexp.MustCompile(`(?i)^/subscriptions/([^/]+)`)
+
 func BuildSender() autorest.Sender {
-	return autorest.DecorateSender(&http.Client{
-		Transport: &http.Transport{
+	return BuildSenderWithOptions(SenderOptions{})
+}
+
+func BuildSenderWithOptions(options SenderOptions) autorest.Sender {
+	transport := options.Transport
+	if transport == nil {
+		transport = &http.Transport{
 			Proxy: http.ProxyFromEnvironment,
-		},
-	}, withRequestLogging())
+		}
+	}
+
+	return autorest.DecorateSender(&http.Client{
+		Transport: transport,
+	}, withRequestLogging(), withConcurrencyLimit(options.MaxConcurrentRequests), withRetries(options.MaxRetries), withTracing(options.Tracer))
 }
 
+// withRequestLogging logs each request and response at DEBUG level - where sensitive headers, query string
+// parameters and JSON fields (such as keys, passwords and connection strings) are redacted, and other bodies are omitted
 func withRequestLogging() autorest.SendDecorator {
 	return func(s autorest.Sender) autorest.Sender {
 		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
-			// strip the authorization header prior to printing
-			authHeaderName := "Authorization"
-			auth := r.Header.Get(authHeaderName)
-			if auth != "" {
-				r.Header.Del(authHeaderName)
-			}
+			log.Printf("[DEBUG] AzureRM Request: \n%s\n", dumpRequest(r))
 
-			// dump request to wire format
-			if dump, err := httputil.DumpRequestOut(r, true); err == nil {
-				log.Printf("[DEBUG] AzureRM Request: \n%s\n", dump)
+			resp, err := s.Do(r)
+			if resp != nil {
+				log.Printf("[DEBUG] AzureRM Response for %s: \n%s\n", tracing.RedactURL(r.URL), dumpResponse(r, resp))
 			} else {
-				// fallback to basic message
-				log.Printf("[DEBUG] AzureRM Request: %s to %s\n", r.Method, r.URL)
+				log.Printf("[DEBUG] Request to %s completed with no response", tracing.RedactURL(r.URL))
 			}
+			return resp, err
+		})
+	}
+}
 
-			// add the auth header back
-			if auth != "" {
-				r.Header.Add(authHeaderName, auth)
-			}
+func dumpRequest(r *http.Request) string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%s %s %s\n", r.Method, tracing.RedactURL(r.URL), r.Proto)
+	tracing.RedactHeaders(r.Header).Write(&buf)
 
-			resp, err := s.Do(r)
+	if r.Body != nil && r.Body != http.NoBody {
+		body, err := ioutil.ReadAll(r.Body)
+		r.Body.Close()
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		if err != nil {
+			fmt.Fprintf(&buf, "\n[error reading body: %+v]", err)
+		} else {
+			buf.WriteString("\n")
+			buf.Write(dumpBody(r.URL, r.Header, body))
+		}
+	}
+
+	return buf.String()
+}
+
+func dumpResponse(r *http.Request, resp *http.Response) string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%s %s\n", resp.Proto, resp.Status)
+	tracing.RedactHeaders(resp.Header).Write(&buf)
+
+	if resp.Body != nil && resp.Body != http.NoBody {
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+		if err != nil {
+			fmt.Fprintf(&buf, "\n[error reading body: %+v]", err)
+		} else {
+			buf.WriteString("\n")
+			buf.Write(dumpBody(r.URL, resp.Header, body))
+		}
+	}
+
+	return buf.String()
+}
+
+func dumpBody(u *url.URL, header http.Header, body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+
+	contentType := header.Get("Content-Type")
+	if !strings.Contains(strings.ToLower(contentType), "json") {
+		return []byte(fmt.Sprintf("[%d bytes of %q omitted]", len(body), contentType))
+	}
+
+	path := ""
+	if u != nil {
+		path = u.Path
+	}
+	return tracing.RedactJSON(path, body)
+}
+
+// withTracing records a Span for each request made to Azure, which is a child of the Span for the Terraform
+// operation (e.g. the Create of a Resource) making the request, when there is one
+func withTracing(tracer *tracing.Tracer) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		if tracer == nil {
+			return s
+		}
+
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			parent := tracing.SpanFromContext(r.Context())
+			ctx, span := tracer.Start(r.Context(), fmt.Sprintf("%s %s", r.Method, r.URL.Path), tracing.SpanKindClient)
+			span.SetAttribute("http.method", r.Method)
+			span.SetAttribute("http.host", r.URL.Host)
+			span.SetAttribute("http.target", tracing.RedactURL(&url.URL{Path: r.URL.Path, RawQuery: r.URL.RawQuery}))
+
+			polling := parent != nil && r.Method == http.MethodGet && parent.IsPolling(r.URL.String())
+			span.SetAttribute("azure.lro.polling", polling)
+
+			resp, err := s.Do(r.WithContext(ctx))
 			if resp != nil {
-				// dump response to wire format
-				if dump, err2 := httputil.DumpResponse(resp, true); err2 == nil {
-					log.Printf("[DEBUG] AzureRM Response for %s: \n%s\n", r.URL, dump)
-				} else {
-					// fallback to basic message
-					log.Printf("[DEBUG] AzureRM Response: %s for %s\n", resp.Status, r.URL)
+				span.SetAttribute("http.status_code", resp.StatusCode)
+				if requestId := resp.Header.Get("x-ms-request-id"); requestId != "" {
+					span.SetAttribute("azure.request_id", requestId)
 				}
-			} else {
-				log.Printf("[DEBUG] Request to %s completed with no response", r.URL)
 			}
+
+			if parent != nil {
+				parent.IncrementAttribute("azure.request_count", 1)
+				if retries, ok := span.Attribute("azure.retry_count").(int); ok {
+					parent.IncrementAttribute("azure.retry_count", int64(retries))
+				}
+
+				if polling {
+					parent.RecordPoll()
+				} else if resp != nil && isLongRunningOperation(r, resp) {
+					parent.StartPolling(resp.Header.Get("Azure-AsyncOperation"), resp.Header.Get("Location"))
+				}
+			}
+
+			span.End(err)
 			return resp, err
 		})
 	}
 }
+
+// isLongRunningOperation returns whether the response indicates a Long Running Operation has been started,
+// which will be polled using the URL in the `Azure-AsyncOperation` or `Location` header
+func isLongRunningOperation(r *http.Request, resp *http.Response) bool {
+	if r.Method == http.MethodGet {
+		return false
+	}
+
+	if resp.Header.Get("Azure-AsyncOperation") != "" {
+		return true
+	}
+
+	return resp.Header.Get("Location") != "" && (resp.StatusCode == http.StatusCreated || resp.StatusCode == http.StatusAccepted)
+}
+
+// withConcurrencyLimit caps the number of in-flight requests per Subscription, since ARM
+// throttles requests on a per-Subscription basis
+func withConcurrencyLimit(maxConcurrentRequests int) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		if maxConcurrentRequests <= 0 {
+			return s
+		}
+
+		limiter := &subscriptionRequestLimiter{
+			limit: maxConcurrentRequests,
+			slots: make(map[string]chan struct{}),
+		}
+
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			subscriptionId := subscriptionIdFromRequest(r)
+			if subscriptionId == "" {
+				return s.Do(r)
+			}
+
+			slots := limiter.slotsForSubscription(subscriptionId)
+			select {
+			case slots <- struct{}{}:
+			case <-r.Context().Done():
+				return nil, r.Context().Err()
+			}
+			defer func() { <-slots }()
+
+			return s.Do(r)
+		})
+	}
+}
+
+type subscriptionRequestLimiter struct {
+	limit int
+	lock  sync.Mutex
+	slots map[string]chan struct{}
+}
+
+func (l *subscriptionRequestLimiter) slotsForSubscription(subscriptionId string) chan struct{} {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	slots, ok := l.slots[subscriptionId]
+	if !ok {
+		slots = make(chan struct{}, l.limit)
+		l.slots[subscriptionId] = slots
+	}
+
+	return slots
+}
+
+func subscriptionIdFromRequest(r *http.Request) string {
+	if r.URL == nil {
+		return ""
+	}
+
+	matches := subscriptionIdFromPathRegex.FindStringSubmatch(r.URL.Path)
+	if len(matches) != 2 {
+		return ""
+	}
+
+	return strings.ToLower(matches[1])
+}
+
+// withRetries retries requests which have been throttled (429) or which have failed
+// with a transient server error (5xx), honouring the `Retry-After` header when present
+func withRetries(maxRetries int) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		if maxRetries <= 0 {
+			return s
+		}
+
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+
+			var resp *http.Response
+			var err error
+			for attempt := 0; ; attempt++ {
+				if err = rr.Prepare(); err != nil {
+					return resp, err
+				}
+
+				resp, err = s.Do(rr.Request())
+				if attempt >= maxRetries || !requestShouldBeRetried(r, resp, err) {
+					return resp, err
+				}
+
+				if span := tracing.SpanFromContext(r.Context()); span != nil {
+					span.SetAttribute("azure.retry_count", attempt+1)
+				}
+
+				delay := retryDelay(resp, attempt)
+				if resp != nil {
+					log.Printf("[DEBUG] AzureRM Request to %s returned %d - retrying in %s (attempt %d of %d)", tracing.RedactURL(r.URL), resp.StatusCode, delay, attempt+1, maxRetries)
+					autorest.Respond(resp, autorest.ByDiscardingBody(), autorest.ByClosing())
+				} else {
+					log.Printf("[DEBUG] AzureRM Request to %s failed with %+v - retrying in %s (attempt %d of %d)", tracing.RedactURL(r.URL), tracing.RedactError(err), delay, attempt+1, maxRetries)
+				}
+
+				select {
+				case <-time.After(delay):
+				case <-r.Context().Done():
+					return resp, r.Context().Err()
+				}
+			}
+		})
+	}
+}
+
+func requestShouldBeRetried(r *http.Request, resp *http.Response, err error) bool {
+	if resp == nil {
+		return utils.ResponseErrorIsRetryable(err)
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	// a POST may not be idempotent, so it's only safe to retry when the request was throttled
+	if r.Method == http.MethodPost {
+		return false
+	}
+
+	return utils.ResponseStatusCodeIsRetryable(resp.StatusCode)
+}
+
+func retryDelay(resp *http.Response, attempt int) time.Duration {
+	if resp != nil {
+		if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return delay
+		}
+	}
+
+	delay := time.Duration(math.Pow(2, float64(attempt))) * time.Second
+	return clampRetryDelay(delay)
+}
+
+// parseRetryAfter parses the value of a `Retry-After` header, which is either a number of seconds or a HTTP Date
+// the delay is capped at `maxRetryDelay` so that a misbehaving server can't stall Terraform indefinitely
+func parseRetryAfter(input string) (time.Duration, bool) {
+	if input == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(input); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return clampRetryDelay(time.Duration(seconds) * time.Second), true
+	}
+
+	if date, err := http.ParseTime(input); err == nil {
+		return clampRetryDelay(time.Until(date)), true
+	}
+
+	return 0, false
+}
+
+func clampRetryDelay(delay time.Duration) time.Duration {
+	if delay < 0 {
+		return 0
+	}
+	if delay > maxRetryDelay {
+		return maxRetryDelay
+	}
+	return delay
+}
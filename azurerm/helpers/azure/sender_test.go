@@ -0,0 +1,335 @@
+package azure
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected time.Duration
+		Valid    bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "hello",
+			Valid: false,
+		},
+		{
+			Input: "-1",
+			Valid: false,
+		},
+		{
+			Input:    "0",
+			Expected: 0,
+			Valid:    true,
+		},
+		{
+			Input:    "17",
+			Expected: 17 * time.Second,
+			Valid:    true,
+		},
+		{
+			Input:    "86400",
+			Expected: maxRetryDelay,
+			Valid:    true,
+		},
+		{
+			Input:    "Wed, 21 Oct 2015 07:28:00 GMT",
+			Expected: 0,
+			Valid:    true,
+		},
+		{
+			Input:    time.Now().Add(24 * time.Hour).UTC().Format(http.TimeFormat),
+			Expected: maxRetryDelay,
+			Valid:    true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, valid := parseRetryAfter(v.Input)
+		if valid != v.Valid {
+			t.Fatalf("Expected %t but got %t for %q", v.Valid, valid, v.Input)
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %s but got %s for %q", v.Expected, actual, v.Input)
+		}
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	testData := []struct {
+		Name       string
+		RetryAfter string
+		Attempt    int
+		Expected   time.Duration
+	}{
+		{
+			Name:     "Exponential Backoff",
+			Attempt:  3,
+			Expected: 8 * time.Second,
+		},
+		{
+			Name:     "Exponential Backoff is Capped",
+			Attempt:  10,
+			Expected: maxRetryDelay,
+		},
+		{
+			Name:       "Retry-After",
+			RetryAfter: "5",
+			Attempt:    10,
+			Expected:   5 * time.Second,
+		},
+		{
+			Name:       "Retry-After is Capped",
+			RetryAfter: "3600",
+			Attempt:    1,
+			Expected:   maxRetryDelay,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		resp := &http.Response{
+			Header: http.Header{},
+		}
+		if v.RetryAfter != "" {
+			resp.Header.Set("Retry-After", v.RetryAfter)
+		}
+
+		actual := retryDelay(resp, v.Attempt)
+		if actual != v.Expected {
+			t.Fatalf("Expected %s but got %s for %q", v.Expected, actual, v.Name)
+		}
+	}
+}
+
+func TestSubscriptionIdFromRequest(t *testing.T) {
+	testData := []struct {
+		Path     string
+		Expected string
+	}{
+		{
+			Path:     "/",
+			Expected: "",
+		},
+		{
+			Path:     "/providers/Microsoft.Management/managementGroups/group1",
+			Expected: "",
+		},
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Expected: "00000000-0000-0000-0000-000000000000",
+		},
+		{
+			Path:     "/SUBSCRIPTIONS/AAAAAAAA-0000-0000-0000-000000000000/providers",
+			Expected: "aaaaaaaa-0000-0000-0000-000000000000",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Path)
+
+		r := &http.Request{
+			URL: &url.URL{
+				Path: v.Path,
+			},
+		}
+		actual := subscriptionIdFromRequest(r)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q for %q", v.Expected, actual, v.Path)
+		}
+	}
+}
+
+func TestWithRetries(t *testing.T) {
+	testData := []struct {
+		Name             string
+		Method           string
+		StatusCodes      []int
+		MaxRetries       int
+		ExpectedStatus   int
+		ExpectedAttempts int
+	}{
+		{
+			Name:             "Success",
+			Method:           http.MethodGet,
+			StatusCodes:      []int{http.StatusOK},
+			MaxRetries:       3,
+			ExpectedStatus:   http.StatusOK,
+			ExpectedAttempts: 1,
+		},
+		{
+			Name:             "Throttled then Success",
+			Method:           http.MethodGet,
+			StatusCodes:      []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			MaxRetries:       3,
+			ExpectedStatus:   http.StatusOK,
+			ExpectedAttempts: 3,
+		},
+		{
+			Name:             "Throttled Exceeding Retries",
+			Method:           http.MethodPut,
+			StatusCodes:      []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests},
+			MaxRetries:       2,
+			ExpectedStatus:   http.StatusTooManyRequests,
+			ExpectedAttempts: 3,
+		},
+		{
+			Name:             "Server Error then Success",
+			Method:           http.MethodDelete,
+			StatusCodes:      []int{http.StatusServiceUnavailable, http.StatusOK},
+			MaxRetries:       3,
+			ExpectedStatus:   http.StatusOK,
+			ExpectedAttempts: 2,
+		},
+		{
+			Name:             "Server Error for a POST isn't retried",
+			Method:           http.MethodPost,
+			StatusCodes:      []int{http.StatusInternalServerError, http.StatusOK},
+			MaxRetries:       3,
+			ExpectedStatus:   http.StatusInternalServerError,
+			ExpectedAttempts: 1,
+		},
+		{
+			Name:             "Throttled POST is retried",
+			Method:           http.MethodPost,
+			StatusCodes:      []int{http.StatusTooManyRequests, http.StatusOK},
+			MaxRetries:       3,
+			ExpectedStatus:   http.StatusOK,
+			ExpectedAttempts: 2,
+		},
+		{
+			Name:             "Client Error isn't retried",
+			Method:           http.MethodGet,
+			StatusCodes:      []int{http.StatusBadRequest, http.StatusOK},
+			MaxRetries:       3,
+			ExpectedStatus:   http.StatusBadRequest,
+			ExpectedAttempts: 1,
+		},
+		{
+			Name:             "Retries Disabled",
+			Method:           http.MethodGet,
+			StatusCodes:      []int{http.StatusTooManyRequests, http.StatusOK},
+			MaxRetries:       0,
+			ExpectedStatus:   http.StatusTooManyRequests,
+			ExpectedAttempts: 1,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		attempts := 0
+		sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			statusCode := v.StatusCodes[attempts]
+			attempts++
+			return &http.Response{
+				StatusCode: statusCode,
+				Header: http.Header{
+					"Retry-After": []string{"0"},
+				},
+				Body:    http.NoBody,
+				Request: r,
+			}, nil
+		})
+
+		r, err := http.NewRequest(v.Method, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000", nil)
+		if err != nil {
+			t.Fatalf("Error building request: %+v", err)
+		}
+
+		resp, err := withRetries(v.MaxRetries)(sender).Do(r)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if resp.StatusCode != v.ExpectedStatus {
+			t.Fatalf("Expected the status code %d but got %d", v.ExpectedStatus, resp.StatusCode)
+		}
+
+		if attempts != v.ExpectedAttempts {
+			t.Fatalf("Expected %d attempts but got %d", v.ExpectedAttempts, attempts)
+		}
+	}
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestWithRetriesRedactsLogs(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	requestUrl := "https://example.blob.core.windows.net/container/blob?sv=2018-11-09&sig=abc123"
+
+	attempts := 0
+	sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		attempts++
+		switch attempts {
+		case 1:
+			return &http.Response{
+				StatusCode: http.StatusServiceUnavailable,
+				Header: http.Header{
+					"Retry-After": []string{"0"},
+				},
+				Body:    http.NoBody,
+				Request: r,
+			}, nil
+		case 2:
+			return nil, &url.Error{Op: "Get", URL: requestUrl, Err: timeoutError{}}
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       http.NoBody,
+			Request:    r,
+		}, nil
+	})
+
+	r, err := http.NewRequest(http.MethodGet, requestUrl, nil)
+	if err != nil {
+		t.Fatalf("Error building request: %+v", err)
+	}
+
+	if _, err := withRetries(3)(sender).Do(r); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if attempts != 3 {
+		t.Fatalf("Expected 3 attempts but got %d", attempts)
+	}
+
+	output := buf.String()
+	if strings.Contains(output, "abc123") {
+		t.Fatalf("Expected the signature to be redacted from the logs but got %q", output)
+	}
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected both retries to be logged but got %q", output)
+	}
+	for _, line := range lines {
+		if !strings.Contains(line, "sig=REDACTED") {
+			t.Fatalf("Expected the retry to be logged with a redacted URL but got %q", line)
+		}
+	}
+}
@@ -0,0 +1,273 @@
+package azure
+
+import (
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+// storageAccountServicePropertiesDefaultVersion is the version of the Storage Analytics used when Logging or
+// Metrics are disabled, since a version must always be specified
+const storageAccountServicePropertiesDefaultVersion = "1.0"
+
+func SchemaStorageAccountCorsRule() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 5,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allowed_origins": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 64,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validate.NoEmptyStrings,
+					},
+				},
+				"exposed_headers": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 64,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validate.NoEmptyStrings,
+					},
+				},
+				"allowed_headers": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 64,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validate.NoEmptyStrings,
+					},
+				},
+				"allowed_methods": {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							"DELETE",
+							"GET",
+							"HEAD",
+							"MERGE",
+							"POST",
+							"OPTIONS",
+							"PUT",
+						}, false),
+					},
+				},
+				"max_age_in_seconds": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(1, 2000000000),
+				},
+			},
+		},
+	}
+}
+
+func SchemaStorageAccountQueueMetrics() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"version": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+				"enabled": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+				"include_apis": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+				"retention_policy_days": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 365),
+				},
+			},
+		},
+	}
+}
+
+func ExpandStorageAccountCorsRules(input []interface{}) *storage.Cors {
+	rules := make([]storage.CorsRule, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		rules = append(rules, storage.CorsRule{
+			AllowedOrigins:  joinStorageAccountCorsValues(v["allowed_origins"].([]interface{})),
+			AllowedMethods:  joinStorageAccountCorsValues(v["allowed_methods"].([]interface{})),
+			AllowedHeaders:  joinStorageAccountCorsValues(v["allowed_headers"].([]interface{})),
+			ExposedHeaders:  joinStorageAccountCorsValues(v["exposed_headers"].([]interface{})),
+			MaxAgeInSeconds: v["max_age_in_seconds"].(int),
+		})
+	}
+
+	return &storage.Cors{
+		CorsRule: rules,
+	}
+}
+
+func FlattenStorageAccountCorsRules(input *storage.Cors) []interface{} {
+	rules := make([]interface{}, 0)
+	if input == nil {
+		return rules
+	}
+
+	for _, rule := range input.CorsRule {
+		rules = append(rules, map[string]interface{}{
+			"allowed_origins":    splitStorageAccountCorsValues(rule.AllowedOrigins),
+			"allowed_methods":    splitStorageAccountCorsValues(rule.AllowedMethods),
+			"allowed_headers":    splitStorageAccountCorsValues(rule.AllowedHeaders),
+			"exposed_headers":    splitStorageAccountCorsValues(rule.ExposedHeaders),
+			"max_age_in_seconds": rule.MaxAgeInSeconds,
+		})
+	}
+
+	return rules
+}
+
+// ExpandStorageAccountQueueLogging expands the `logging` block - where Logging is disabled when it's not specified
+func ExpandStorageAccountQueueLogging(input []interface{}) *storage.Logging {
+	if len(input) == 0 || input[0] == nil {
+		return &storage.Logging{
+			Version: storageAccountServicePropertiesDefaultVersion,
+			RetentionPolicy: &storage.RetentionPolicy{
+				Enabled: false,
+			},
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	return &storage.Logging{
+		Version:         v["version"].(string),
+		Delete:          v["delete"].(bool),
+		Read:            v["read"].(bool),
+		Write:           v["write"].(bool),
+		RetentionPolicy: expandStorageAccountRetentionPolicy(v["retention_policy_days"].(int)),
+	}
+}
+
+func FlattenStorageAccountQueueLogging(input *storage.Logging) []interface{} {
+	if input == nil || (!input.Delete && !input.Read && !input.Write) {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"version":               input.Version,
+			"delete":                input.Delete,
+			"read":                  input.Read,
+			"write":                 input.Write,
+			"retention_policy_days": flattenStorageAccountRetentionPolicy(input.RetentionPolicy),
+		},
+	}
+}
+
+// ExpandStorageAccountQueueMetrics expands a `hour_metrics` or `minute_metrics` block - where the Metrics are disabled
+// when it's not specified
+func ExpandStorageAccountQueueMetrics(input []interface{}) *storage.Metrics {
+	if len(input) == 0 || input[0] == nil {
+		return &storage.Metrics{
+			Version: storageAccountServicePropertiesDefaultVersion,
+			Enabled: false,
+			RetentionPolicy: &storage.RetentionPolicy{
+				Enabled: false,
+			},
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	enabled := v["enabled"].(bool)
+	metrics := &storage.Metrics{
+		Version:         v["version"].(string),
+		Enabled:         enabled,
+		RetentionPolicy: expandStorageAccountRetentionPolicy(v["retention_policy_days"].(int)),
+	}
+
+	// `IncludeAPIs` can only be specified when the Metrics are enabled
+	if enabled {
+		includeAPIs := v["include_apis"].(bool)
+		metrics.IncludeAPIs = &includeAPIs
+	}
+
+	return metrics
+}
+
+func FlattenStorageAccountQueueMetrics(input *storage.Metrics) []interface{} {
+	if input == nil || !input.Enabled {
+		return []interface{}{}
+	}
+
+	includeAPIs := false
+	if input.IncludeAPIs != nil {
+		includeAPIs = *input.IncludeAPIs
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"version":               input.Version,
+			"enabled":               input.Enabled,
+			"include_apis":          includeAPIs,
+			"retention_policy_days": flattenStorageAccountRetentionPolicy(input.RetentionPolicy),
+		},
+	}
+}
+
+func expandStorageAccountRetentionPolicy(days int) *storage.RetentionPolicy {
+	if days == 0 {
+		return &storage.RetentionPolicy{
+			Enabled: false,
+		}
+	}
+
+	return &storage.RetentionPolicy{
+		Enabled: true,
+		Days:    &days,
+	}
+}
+
+func flattenStorageAccountRetentionPolicy(input *storage.RetentionPolicy) int {
+	if input == nil || !input.Enabled || input.Days == nil {
+		return 0
+	}
+
+	return *input.Days
+}
+
+func joinStorageAccountCorsValues(input []interface{}) string {
+	values := make([]string, 0)
+	for _, v := range input {
+		values = append(values, v.(string))
+	}
+
+	return strings.Join(values, ",")
+}
+
+func splitStorageAccountCorsValues(input string) []interface{} {
+	values := make([]interface{}, 0)
+	for _, v := range strings.Split(input, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
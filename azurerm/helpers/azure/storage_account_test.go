@@ -0,0 +1,135 @@
+package azure
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+)
+
+func TestStorageAccountCorsRules_roundTrip(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"allowed_origins":    []interface{}{"http://www.example.com", "http://www.example.org"},
+			"allowed_methods":    []interface{}{"GET", "HEAD"},
+			"allowed_headers":    []interface{}{"x-ms-meta-*"},
+			"exposed_headers":    []interface{}{"*"},
+			"max_age_in_seconds": 3600,
+		},
+	}
+
+	expanded := ExpandStorageAccountCorsRules(input)
+	if len(expanded.CorsRule) != 1 {
+		t.Fatalf("Expected 1 CORS Rule but got %d", len(expanded.CorsRule))
+	}
+	if v := expanded.CorsRule[0].AllowedOrigins; v != "http://www.example.com,http://www.example.org" {
+		t.Fatalf("Expected the Allowed Origins to be comma-separated but got %q", v)
+	}
+
+	flattened := FlattenStorageAccountCorsRules(expanded)
+	if !reflect.DeepEqual(flattened, input) {
+		t.Fatalf("Expected %+v but got %+v", input, flattened)
+	}
+}
+
+func TestFlattenStorageAccountCorsRules_whitespace(t *testing.T) {
+	input := &storage.Cors{
+		CorsRule: []storage.CorsRule{
+			{
+				AllowedOrigins: "http://www.example.com, http://www.example.org",
+				AllowedMethods: "GET",
+			},
+		},
+	}
+
+	actual := FlattenStorageAccountCorsRules(input)[0].(map[string]interface{})
+	expected := []interface{}{"http://www.example.com", "http://www.example.org"}
+	if !reflect.DeepEqual(actual["allowed_origins"], expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual["allowed_origins"])
+	}
+	if v := actual["allowed_headers"].([]interface{}); len(v) != 0 {
+		t.Fatalf("Expected no Allowed Headers but got %+v", v)
+	}
+}
+
+func TestExpandStorageAccountQueueMetrics(t *testing.T) {
+	testData := []struct {
+		Name                string
+		Input               []interface{}
+		ExpectedEnabled     bool
+		ExpectedIncludeAPIs *bool
+		ExpectedRetention   bool
+	}{
+		{
+			Name:              "Not Specified",
+			Input:             []interface{}{},
+			ExpectedEnabled:   false,
+			ExpectedRetention: false,
+		},
+		{
+			Name: "Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"version":               "1.0",
+					"enabled":               false,
+					"include_apis":          true,
+					"retention_policy_days": 0,
+				},
+			},
+			ExpectedEnabled:   false,
+			ExpectedRetention: false,
+		},
+		{
+			Name: "Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"version":               "1.0",
+					"enabled":               true,
+					"include_apis":          true,
+					"retention_policy_days": 7,
+				},
+			},
+			ExpectedEnabled:     true,
+			ExpectedIncludeAPIs: func() *bool { v := true; return &v }(),
+			ExpectedRetention:   true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := ExpandStorageAccountQueueMetrics(v.Input)
+		if actual.Version == "" {
+			t.Fatalf("Expected a Version to be set")
+		}
+		if actual.Enabled != v.ExpectedEnabled {
+			t.Fatalf("Expected Enabled to be %t but got %t", v.ExpectedEnabled, actual.Enabled)
+		}
+		if !reflect.DeepEqual(actual.IncludeAPIs, v.ExpectedIncludeAPIs) {
+			t.Fatalf("Expected IncludeAPIs to be %+v but got %+v", v.ExpectedIncludeAPIs, actual.IncludeAPIs)
+		}
+		if actual.RetentionPolicy == nil || actual.RetentionPolicy.Enabled != v.ExpectedRetention {
+			t.Fatalf("Expected the Retention Policy to be enabled: %t but got %+v", v.ExpectedRetention, actual.RetentionPolicy)
+		}
+	}
+}
+
+func TestFlattenStorageAccountQueueLogging(t *testing.T) {
+	if v := FlattenStorageAccountQueueLogging(ExpandStorageAccountQueueLogging(nil)); len(v) != 0 {
+		t.Fatalf("Expected disabled Logging to be omitted but got %+v", v)
+	}
+
+	input := []interface{}{
+		map[string]interface{}{
+			"version":               "1.0",
+			"delete":                true,
+			"read":                  false,
+			"write":                 true,
+			"retention_policy_days": 10,
+		},
+	}
+	actual := FlattenStorageAccountQueueLogging(ExpandStorageAccountQueueLogging(input))
+	if !reflect.DeepEqual(actual, input) {
+		t.Fatalf("Expected %+v but got %+v", input, actual)
+	}
+}
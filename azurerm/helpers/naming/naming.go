@@ -0,0 +1,120 @@
+// Package naming contains the naming rules (length, allowed characters and the scope within which the name must
+// be unique) for each type of Resource, which are used to validate the `name` field at plan time.
+package naming
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Scope is the scope within which the name of a Resource must be unique
+type Scope string
+
+const (
+	// Global means the name must be unique across all of Azure - for example since it's used as a part of a hostname
+	Global Scope = "Global"
+
+	// Subscription means the name must be unique within the Subscription
+	Subscription Scope = "Subscription"
+
+	// ResourceGroup means the name must be unique within the Resource Group
+	ResourceGroup Scope = "ResourceGroup"
+
+	// Parent means the name must be unique within the Parent Resource - for example a Subnet within a Virtual Network
+	Parent Scope = "Parent"
+)
+
+// Rule defines the naming requirements for a type of Resource
+type Rule struct {
+	// MinLength is the minimum length of the name - all names must be at least 1 character
+	MinLength int
+
+	// MaxLength is the maximum length of the name, where 0 means there's no limit
+	MaxLength int
+
+	// Pattern is a Regular Expression which the name must match
+	Pattern *regexp.Regexp
+
+	// PatternDescription is a human readable description of the Pattern, e.g. `lowercase letters and numbers`
+	PatternDescription string
+
+	// Validate is an optional function for requirements which can't be expressed using a Pattern
+	Validate schema.SchemaValidateFunc
+
+	// Scope is the scope within which the name must be unique
+	Scope Scope
+}
+
+// RuleFor returns the naming Rule for the specified type of Resource (e.g. `azurerm_storage_account`)
+func RuleFor(resourceType string) (*Rule, bool) {
+	rule, ok := rules[resourceType]
+	if !ok {
+		return nil, false
+	}
+
+	return &rule, true
+}
+
+// Validate returns a ValidateFunc which validates the name of the specified type of Resource (e.g.
+// `azurerm_storage_account`) against the naming rules for it, so that invalid names are caught at
+// plan time rather than being rejected by the API during an apply.
+//
+// Since this is called when the Provider's Schema is built, this panics when there's no naming rule
+// defined for the specified type of Resource.
+func Validate(resourceType string) schema.SchemaValidateFunc {
+	rule, ok := rules[resourceType]
+	if !ok {
+		panic(fmt.Sprintf("no naming rule is defined for %q", resourceType))
+	}
+
+	return rule.validate
+}
+
+func (r Rule) validate(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if strings.TrimSpace(v) == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return warnings, errors
+	}
+
+	minLength := r.MinLength
+	if minLength < 1 {
+		minLength = 1
+	}
+
+	if len(v) < minLength || (r.MaxLength > 0 && len(v) > r.MaxLength) {
+		if r.MaxLength > 0 {
+			errors = append(errors, fmt.Errorf("%q must be between %d and %d characters in length, got %d: %q", k, minLength, r.MaxLength, len(v), v))
+		} else {
+			errors = append(errors, fmt.Errorf("%q must be at least %d characters in length, got %d: %q", k, minLength, len(v), v))
+		}
+	}
+
+	if r.Pattern != nil && !r.Pattern.MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q %s: %q", k, r.PatternDescription, v))
+	}
+
+	if r.Validate != nil {
+		w, es := r.Validate(v, k)
+		warnings = append(warnings, w...)
+		errors = append(errors, es...)
+	}
+
+	return warnings, errors
+}
+
+func noConsecutiveHyphens(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && strings.Contains(v, "--") {
+		errors = append(errors, fmt.Errorf("%q must not contain consecutive hyphens: %q", k, v))
+	}
+
+	return warnings, errors
+}
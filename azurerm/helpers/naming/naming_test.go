@@ -0,0 +1,135 @@
+package naming
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	testData := []struct {
+		ResourceType string
+		Value        string
+		ErrCount     int
+	}{
+		{
+			ResourceType: "azurerm_storage_account",
+			Value:        "",
+			ErrCount:     1,
+		},
+		{
+			ResourceType: "azurerm_storage_account",
+			Value:        "ab",
+			ErrCount:     1,
+		},
+		{
+			ResourceType: "azurerm_storage_account",
+			Value:        "abc123",
+			ErrCount:     0,
+		},
+		{
+			ResourceType: "azurerm_storage_account",
+			Value:        "ABC123",
+			ErrCount:     1,
+		},
+		{
+			ResourceType: "azurerm_storage_account",
+			Value:        "ab-c",
+			ErrCount:     1,
+		},
+		{
+			ResourceType: "azurerm_storage_account",
+			Value:        strings.Repeat("A", 25),
+			ErrCount:     2,
+		},
+		{
+			ResourceType: "azurerm_key_vault",
+			Value:        "hello-world",
+			ErrCount:     0,
+		},
+		{
+			ResourceType: "azurerm_key_vault",
+			Value:        "1hello",
+			ErrCount:     1,
+		},
+		{
+			ResourceType: "azurerm_key_vault",
+			Value:        "hello-",
+			ErrCount:     1,
+		},
+		{
+			ResourceType: "azurerm_key_vault",
+			Value:        "hello--world",
+			ErrCount:     1,
+		},
+		{
+			ResourceType: "azurerm_sql_server",
+			Value:        "my-sql-server",
+			ErrCount:     0,
+		},
+		{
+			ResourceType: "azurerm_sql_server",
+			Value:        "My-SQL-Server",
+			ErrCount:     1,
+		},
+		{
+			ResourceType: "azurerm_sql_server",
+			Value:        "-sqlserver",
+			ErrCount:     1,
+		},
+		{
+			ResourceType: "azurerm_storage_table",
+			Value:        "table",
+			ErrCount:     1,
+		},
+		{
+			ResourceType: "azurerm_virtual_machine_extension",
+			Value:        " ",
+			ErrCount:     1,
+		},
+		{
+			ResourceType: "azurerm_virtual_machine_extension",
+			Value:        "CustomScript",
+			ErrCount:     0,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q for %q", v.Value, v.ResourceType)
+
+		_, errors := Validate(v.ResourceType)(v.Value, "name")
+		if len(errors) != v.ErrCount {
+			t.Fatalf("Expected %d errors but got %d: %+v", v.ErrCount, len(errors), errors)
+		}
+	}
+}
+
+func TestValidateNonStringValue(t *testing.T) {
+	_, errors := Validate("azurerm_storage_account")(1, "name")
+	if len(errors) != 1 {
+		t.Fatalf("Expected 1 error but got %d", len(errors))
+	}
+}
+
+func TestValidateUnknownResourceType(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("Expected a panic for an unknown Resource Type")
+		}
+	}()
+
+	Validate("azurerm_does_not_exist")
+}
+
+func TestRuleFor(t *testing.T) {
+	rule, ok := RuleFor("azurerm_storage_account")
+	if !ok {
+		t.Fatalf("Expected a naming rule for `azurerm_storage_account`")
+	}
+	if rule.Scope != Global {
+		t.Fatalf("Expected the Scope to be %q but got %q", Global, rule.Scope)
+	}
+
+	if _, ok := RuleFor("azurerm_does_not_exist"); ok {
+		t.Fatalf("Expected no naming rule for `azurerm_does_not_exist`")
+	}
+}
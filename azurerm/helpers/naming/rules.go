@@ -0,0 +1,604 @@
+package naming
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+// the naming rules for Network resources, from the Portal:
+// The name must begin with a letter or number, end with a letter, number or underscore, and may contain only letters, numbers, underscores, periods, or hyphens.
+var networkPattern = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9._-]*[a-zA-Z0-9_])?$`)
+
+const networkPatternDescription = "must begin with a letter or number, end with a letter, number or underscore, and may contain only letters, numbers, underscores, periods, or hyphens"
+
+func networkRule(scope Scope) Rule {
+	return Rule{
+		MaxLength:          80,
+		Pattern:            networkPattern,
+		PatternDescription: networkPatternDescription,
+		Scope:              scope,
+	}
+}
+
+// the naming rules for Data Factory child resources, see https://docs.microsoft.com/en-us/azure/data-factory/naming-rules
+var dataFactoryLinkedServiceDatasetRule = Rule{
+	MaxLength:          260,
+	Pattern:            regexp.MustCompile(`^[a-zA-Z0-9_][^-.+?/<>*%&:\\]*$`),
+	PatternDescription: `must begin with a letter, number or underscore and cannot contain any of '-', '.', '+', '?', '/', '<', '>', '*', '%', '&', ':' or '\'`,
+	Scope:              Parent,
+}
+
+var appServiceRule = Rule{
+	MaxLength:          60,
+	Pattern:            regexp.MustCompile(`^[0-9a-zA-Z-]+$`),
+	PatternDescription: "may only contain alphanumeric characters and dashes",
+	Scope:              Global,
+}
+
+var mySqlPostgreSqlServerRule = Rule{
+	MinLength:          3,
+	MaxLength:          63,
+	Pattern:            regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`),
+	PatternDescription: "may only contain lowercase letters, numbers and hyphens, and must begin and end with a letter or number",
+	Scope:              Global,
+}
+
+var virtualMachineRule = Rule{
+	MaxLength: 64,
+	Scope:     ResourceGroup,
+}
+
+var resourceGroupScoped = Rule{Scope: ResourceGroup}
+var parentScoped = Rule{Scope: Parent}
+var subscriptionScoped = Rule{Scope: Subscription}
+
+// rules contains the naming rules for each type of Resource which has a user-specified name - where a
+// Resource has no documented naming restrictions only the Scope is defined, so the name must not be empty
+var rules = map[string]Rule{
+	"azurerm_api_management": {
+		Validate: validate.ApiManagementServiceName,
+		Scope:    Global,
+	},
+	"azurerm_api_management_api": {
+		Validate: validate.ApiManagementApiName,
+		Scope:    Parent,
+	},
+	"azurerm_api_management_api_version_set":         {Validate: validate.ApiManagementChildName, Scope: Parent},
+	"azurerm_api_management_authorization_server":    {Validate: validate.ApiManagementChildName, Scope: Parent},
+	"azurerm_api_management_certificate":             {Validate: validate.ApiManagementChildName, Scope: Parent},
+	"azurerm_api_management_group":                   {Validate: validate.ApiManagementChildName, Scope: Parent},
+	"azurerm_api_management_logger":                  {Validate: validate.ApiManagementChildName, Scope: Parent},
+	"azurerm_api_management_openid_connect_provider": {Validate: validate.ApiManagementChildName, Scope: Parent},
+	"azurerm_api_management_property":                {Validate: validate.ApiManagementChildName, Scope: Parent},
+	"azurerm_app_service":                            appServiceRule,
+	"azurerm_app_service_plan": {
+		MaxLength:          60,
+		Pattern:            regexp.MustCompile(`^[0-9a-zA-Z_-]+$`),
+		PatternDescription: "may only contain alphanumeric characters, dashes and underscores",
+		Scope:              ResourceGroup,
+	},
+	"azurerm_app_service_slot": {
+		MaxLength:          appServiceRule.MaxLength,
+		Pattern:            appServiceRule.Pattern,
+		PatternDescription: appServiceRule.PatternDescription,
+		Scope:              Parent,
+	},
+	"azurerm_application_gateway":           networkRule(ResourceGroup),
+	"azurerm_application_insights":          resourceGroupScoped,
+	"azurerm_application_insights_api_key":  parentScoped,
+	"azurerm_application_insights_web_test": resourceGroupScoped,
+	"azurerm_application_security_group":    networkRule(ResourceGroup),
+	"azurerm_automation_account": {
+		MaxLength:          50,
+		Pattern:            regexp.MustCompile(`^[0-9a-zA-Z]([-0-9a-zA-Z]*[0-9a-zA-Z])?$`),
+		PatternDescription: "must start with a letter or number, end with a letter or number and can contain letters, numbers, and dashes",
+		Scope:              ResourceGroup,
+	},
+	"azurerm_automation_credential": parentScoped,
+	"azurerm_automation_dsc_configuration": {
+		MaxLength:          64,
+		Pattern:            regexp.MustCompile(`^[a-zA-Z0-9_]+$`),
+		PatternDescription: "can only contain letters, numbers and underscores",
+		Scope:              Parent,
+	},
+	"azurerm_automation_dsc_nodeconfiguration": parentScoped,
+	"azurerm_automation_module":                parentScoped,
+	"azurerm_automation_runbook":               parentScoped,
+	"azurerm_automation_schedule": {
+		MaxLength:          128,
+		Pattern:            regexp.MustCompile(`^[^<>*%&:\\?.+/]*[^<>*%&:\\?.+/\s]$`),
+		PatternDescription: `cannot contain special characters < > * % & : \ ? . + / and cannot end with a whitespace character`,
+		Scope:              Parent,
+	},
+	"azurerm_automation_variable_bool":     parentScoped,
+	"azurerm_automation_variable_datetime": parentScoped,
+	"azurerm_automation_variable_int":      parentScoped,
+	"azurerm_automation_variable_string":   parentScoped,
+	"azurerm_autoscale_setting":            resourceGroupScoped,
+	"azurerm_availability_set":             virtualMachineRule,
+	"azurerm_azuread_application":          {Scope: Global},
+	"azurerm_batch_account": {
+		MinLength:          3,
+		MaxLength:          24,
+		Pattern:            regexp.MustCompile(`^[a-z0-9]+$`),
+		PatternDescription: "can only contain lowercase letters and numbers",
+		Scope:              Global,
+	},
+	"azurerm_batch_pool": {
+		Validate: azure.ValidateAzureRMBatchPoolName,
+		Scope:    Parent,
+	},
+	"azurerm_cdn_endpoint": {
+		MaxLength:          50,
+		Pattern:            regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?$`),
+		PatternDescription: "may only contain alphanumeric characters and hyphens, and must begin and end with a letter or number",
+		Scope:              Global,
+	},
+	"azurerm_cdn_profile": {
+		MaxLength:          260,
+		Pattern:            regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?$`),
+		PatternDescription: "may only contain alphanumeric characters and hyphens, and must begin and end with a letter or number",
+		Scope:              ResourceGroup,
+	},
+	"azurerm_cognitive_account": {
+		Validate: validate.CognitiveServicesAccountName(),
+		Scope:    ResourceGroup,
+	},
+	"azurerm_connection_monitor": parentScoped,
+	"azurerm_container_group": {
+		MaxLength:          63,
+		Pattern:            regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`),
+		PatternDescription: "may only contain lowercase letters, numbers and hyphens, and must begin and end with a letter or number",
+		Scope:              ResourceGroup,
+	},
+	"azurerm_container_registry": {
+		MinLength:          5,
+		MaxLength:          49,
+		Pattern:            regexp.MustCompile(`^[a-zA-Z0-9]+$`),
+		PatternDescription: "can only contain alpha numeric characters",
+		Scope:              Global,
+	},
+	"azurerm_container_service": resourceGroupScoped,
+	"azurerm_cosmosdb_account": {
+		MinLength:          3,
+		MaxLength:          50,
+		Pattern:            regexp.MustCompile(`^[-a-z0-9]+$`),
+		PatternDescription: "can only contain lowercase letters, numbers and hyphens",
+		Scope:              Global,
+	},
+	"azurerm_cosmosdb_cassandra_keyspace": {Validate: validate.CosmosEntityName, Scope: Parent},
+	"azurerm_cosmosdb_mongo_collection":   {Validate: validate.CosmosEntityName, Scope: Parent},
+	"azurerm_cosmosdb_mongo_database":     {Validate: validate.CosmosEntityName, Scope: Parent},
+	"azurerm_cosmosdb_sql_database":       {Validate: validate.CosmosEntityName, Scope: Parent},
+	"azurerm_cosmosdb_table":              {Validate: validate.CosmosEntityName, Scope: Parent},
+	"azurerm_data_factory": {
+		MinLength:          3,
+		MaxLength:          63,
+		Pattern:            regexp.MustCompile(`^[A-Za-z0-9]+(?:-[A-Za-z0-9]+)*$`),
+		PatternDescription: "can only contain letters, numbers and hyphens, and must begin and end with a letter or number and cannot contain consecutive hyphens",
+		Scope:              Global,
+	},
+	"azurerm_data_factory_dataset_mysql":                         dataFactoryLinkedServiceDatasetRule,
+	"azurerm_data_factory_dataset_postgresql":                    dataFactoryLinkedServiceDatasetRule,
+	"azurerm_data_factory_dataset_sql_server_table":              dataFactoryLinkedServiceDatasetRule,
+	"azurerm_data_factory_linked_service_data_lake_storage_gen2": dataFactoryLinkedServiceDatasetRule,
+	"azurerm_data_factory_linked_service_mysql":                  dataFactoryLinkedServiceDatasetRule,
+	"azurerm_data_factory_linked_service_postgresql":             dataFactoryLinkedServiceDatasetRule,
+	"azurerm_data_factory_linked_service_sql_server":             dataFactoryLinkedServiceDatasetRule,
+	"azurerm_data_factory_pipeline": {
+		MaxLength:          260,
+		Pattern:            regexp.MustCompile(`^[a-zA-Z0-9_][^.+?/<>*%&:\\]*$`),
+		PatternDescription: `must begin with a letter, number or underscore and cannot contain any of '.', '+', '?', '/', '<', '>', '*', '%', '&', ':' or '\'`,
+		Scope:              Parent,
+	},
+	"azurerm_data_lake_analytics_account":       {Validate: azure.ValidateDataLakeAccountName(), Scope: Global},
+	"azurerm_data_lake_analytics_firewall_rule": {Validate: azure.ValidateDataLakeFirewallRuleName(), Scope: Parent},
+	"azurerm_data_lake_store":                   {Validate: azure.ValidateDataLakeAccountName(), Scope: Global},
+	"azurerm_data_lake_store_firewall_rule":     {Validate: azure.ValidateDataLakeFirewallRuleName(), Scope: Parent},
+	"azurerm_databricks_workspace": {
+		// NOTE: Restricted name to 30 characters because that is the restriction in Azure Portal even though the API supports 64 characters
+		MinLength:          3,
+		MaxLength:          30,
+		Pattern:            regexp.MustCompile(`^[a-zA-Z0-9]{2}[-a-zA-Z0-9]*[a-zA-Z0-9]$`),
+		PatternDescription: "can only contain letters, numbers, and hyphens and the first, second, and last characters must be a letter or number",
+		Validate:           noConsecutiveHyphens,
+		Scope:              ResourceGroup,
+	},
+	"azurerm_ddos_protection_plan":             networkRule(ResourceGroup),
+	"azurerm_dev_test_lab":                     {Validate: validate.DevTestLabName(), Scope: ResourceGroup},
+	"azurerm_dev_test_linux_virtual_machine":   {Validate: validate.DevTestVirtualMachineName(62), Scope: Parent},
+	"azurerm_dev_test_windows_virtual_machine": {Validate: validate.DevTestVirtualMachineName(15), Scope: Parent},
+	"azurerm_dev_test_virtual_network": {
+		Pattern:            regexp.MustCompile(`^[A-Za-z0-9_-]+$`),
+		PatternDescription: "can only include alphanumeric characters, underscores, hyphens",
+		Scope:              Parent,
+	},
+	"azurerm_devspace_controller": {Validate: validate.DevSpaceName(), Scope: ResourceGroup},
+	"azurerm_dns_a_record":        parentScoped,
+	"azurerm_dns_aaaa_record":     parentScoped,
+	"azurerm_dns_caa_record":      parentScoped,
+	"azurerm_dns_cname_record":    parentScoped,
+	"azurerm_dns_mx_record":       parentScoped,
+	"azurerm_dns_ns_record":       parentScoped,
+	"azurerm_dns_ptr_record":      parentScoped,
+	"azurerm_dns_srv_record":      parentScoped,
+	"azurerm_dns_txt_record":      parentScoped,
+	"azurerm_dns_zone":            resourceGroupScoped,
+	"azurerm_eventgrid_domain": {
+		MinLength:          3,
+		MaxLength:          50,
+		Pattern:            regexp.MustCompile(`^[a-zA-Z0-9-]+$`),
+		PatternDescription: "can only contain letters, numbers and hyphens",
+		Scope:              ResourceGroup,
+	},
+	"azurerm_eventgrid_event_subscription": parentScoped,
+	"azurerm_eventgrid_topic": {
+		MinLength:          3,
+		MaxLength:          50,
+		Pattern:            regexp.MustCompile(`^[a-zA-Z0-9-]+$`),
+		PatternDescription: "can only contain letters, numbers and hyphens",
+		Scope:              ResourceGroup,
+	},
+	"azurerm_eventhub":                              {Validate: azure.ValidateEventHubName(), Scope: Parent},
+	"azurerm_eventhub_authorization_rule":           {Validate: azure.ValidateEventHubAuthorizationRuleName(), Scope: Parent},
+	"azurerm_eventhub_consumer_group":               {Validate: azure.ValidateEventHubConsumerName(), Scope: Parent},
+	"azurerm_eventhub_namespace":                    {Validate: azure.ValidateEventHubNamespaceName(), Scope: Global},
+	"azurerm_eventhub_namespace_authorization_rule": {Validate: azure.ValidateEventHubAuthorizationRuleName(), Scope: Parent},
+	"azurerm_express_route_circuit":                 networkRule(ResourceGroup),
+	"azurerm_express_route_circuit_authorization":   networkRule(Parent),
+	"azurerm_firewall":                              networkRule(ResourceGroup),
+	"azurerm_firewall_application_rule_collection":  networkRule(Parent),
+	"azurerm_firewall_nat_rule_collection":          networkRule(Parent),
+	"azurerm_firewall_network_rule_collection":      networkRule(Parent),
+	"azurerm_function_app":                          appServiceRule,
+	"azurerm_hdinsight_hadoop_cluster":              {Validate: validate.HDInsightName, Scope: Global},
+	"azurerm_hdinsight_hbase_cluster":               {Validate: validate.HDInsightName, Scope: Global},
+	"azurerm_hdinsight_interactive_query_cluster":   {Validate: validate.HDInsightName, Scope: Global},
+	"azurerm_hdinsight_kafka_cluster":               {Validate: validate.HDInsightName, Scope: Global},
+	"azurerm_hdinsight_ml_services_cluster":         {Validate: validate.HDInsightName, Scope: Global},
+	"azurerm_hdinsight_rserver_cluster":             {Validate: validate.HDInsightName, Scope: Global},
+	"azurerm_hdinsight_spark_cluster":               {Validate: validate.HDInsightName, Scope: Global},
+	"azurerm_hdinsight_storm_cluster":               {Validate: validate.HDInsightName, Scope: Global},
+	"azurerm_image":                                 resourceGroupScoped,
+	"azurerm_iothub":                                {Validate: validate.IoTHubName, Scope: Global},
+	"azurerm_iothub_consumer_group":                 {Validate: validate.IoTHubConsumerGroupName, Scope: Parent},
+	"azurerm_iothub_shared_access_policy": {
+		MaxLength:          64,
+		Pattern:            regexp.MustCompile(`^[a-zA-Z0-9!._-]+$`),
+		PatternDescription: "can only contain alphanumeric characters, exclamation marks, periods, underscores and hyphens",
+		Scope:              Parent,
+	},
+	"azurerm_key_vault": {
+		MinLength:          3,
+		MaxLength:          24,
+		Pattern:            regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]*[a-zA-Z0-9]$`),
+		PatternDescription: "may only contain alphanumeric characters and dashes, must start with a letter and must end with a letter or number",
+		Validate:           noConsecutiveHyphens,
+		Scope:              Global,
+	},
+	"azurerm_key_vault_certificate": {Validate: azure.ValidateKeyVaultChildName, Scope: Parent},
+	"azurerm_key_vault_key":         {Validate: azure.ValidateKeyVaultChildName, Scope: Parent},
+	"azurerm_key_vault_secret":      {Validate: azure.ValidateKeyVaultChildName, Scope: Parent},
+	"azurerm_kubernetes_cluster": {
+		MaxLength:          63,
+		Pattern:            regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9_-]*[a-zA-Z0-9])?$`),
+		PatternDescription: "can only contain letters, numbers, underscores and hyphens, and must begin and end with a letter or number",
+		Scope:              ResourceGroup,
+	},
+	"azurerm_kubernetes_cluster_node_pool": {Validate: validate.KubernetesAgentPoolName, Scope: Parent},
+	"azurerm_lb":                           networkRule(ResourceGroup),
+	"azurerm_lb_backend_address_pool":      networkRule(Parent),
+	"azurerm_lb_nat_pool":                  networkRule(Parent),
+	"azurerm_lb_nat_rule":                  networkRule(Parent),
+	"azurerm_lb_outbound_rule":             networkRule(Parent),
+	"azurerm_lb_probe":                     networkRule(Parent),
+	"azurerm_lb_rule":                      networkRule(Parent),
+	"azurerm_local_network_gateway":        networkRule(ResourceGroup),
+	"azurerm_log_analytics_workspace": {
+		MinLength:          4,
+		MaxLength:          63,
+		Pattern:            regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9-]+[A-Za-z0-9]$`),
+		PatternDescription: "can only contain alphabet, number, and '-' character and can not use '-' as the start and end of the name",
+		Scope:              ResourceGroup,
+	},
+	"azurerm_logic_app_action_custom":        parentScoped,
+	"azurerm_logic_app_action_http":          parentScoped,
+	"azurerm_logic_app_trigger_custom":       parentScoped,
+	"azurerm_logic_app_trigger_http_request": parentScoped,
+	"azurerm_logic_app_trigger_recurrence":   parentScoped,
+	"azurerm_logic_app_workflow":             resourceGroupScoped,
+	"azurerm_managed_disk": {
+		MaxLength:          80,
+		Pattern:            regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`),
+		PatternDescription: "can only contain alphanumeric characters, underscores, periods and hyphens",
+		Scope:              ResourceGroup,
+	},
+	"azurerm_management_lock": {
+		MaxLength:          259,
+		Pattern:            regexp.MustCompile(`^[^<>%&:\\?/]+$`),
+		PatternDescription: `cannot contain any of '<', '>', '%', '&', ':', '\', '?' or '/'`,
+		Scope:              Parent,
+	},
+	"azurerm_mariadb_database": {
+		MaxLength:          64,
+		Pattern:            regexp.MustCompile(`^[_a-zA-Z0-9]+$`),
+		PatternDescription: "can only contain letters, numbers and underscores",
+		Scope:              Parent,
+	},
+	"azurerm_mariadb_server": {
+		MinLength:          3,
+		MaxLength:          50,
+		Pattern:            regexp.MustCompile(`^[-a-zA-Z0-9]+$`),
+		PatternDescription: "can only contain letters, numbers and hyphens",
+		Scope:              Global,
+	},
+	"azurerm_media_services_account": {
+		MinLength:          3,
+		MaxLength:          24,
+		Pattern:            regexp.MustCompile(`^[-a-z0-9]+$`),
+		PatternDescription: "can only contain lowercase letters and numbers",
+		Scope:              Global,
+	},
+	"azurerm_metric_alertrule":                    resourceGroupScoped,
+	"azurerm_monitor_action_group":                resourceGroupScoped,
+	"azurerm_monitor_activity_log_alert":          resourceGroupScoped,
+	"azurerm_monitor_autoscale_setting":           resourceGroupScoped,
+	"azurerm_monitor_diagnostic_setting":          parentScoped,
+	"azurerm_monitor_log_profile":                 subscriptionScoped,
+	"azurerm_monitor_metric_alert":                resourceGroupScoped,
+	"azurerm_monitor_metric_alertrule":            resourceGroupScoped,
+	"azurerm_mssql_elasticpool":                   {Validate: azure.ValidateMsSqlElasticPoolName, Scope: Parent},
+	"azurerm_mysql_configuration":                 parentScoped,
+	"azurerm_mysql_database":                      parentScoped,
+	"azurerm_mysql_firewall_rule":                 parentScoped,
+	"azurerm_mysql_server":                        mySqlPostgreSqlServerRule,
+	"azurerm_mysql_virtual_network_rule":          {Validate: validate.VirtualNetworkRuleName, Scope: Parent},
+	"azurerm_nat_gateway":                         networkRule(ResourceGroup),
+	"azurerm_network_connection_monitor":          parentScoped,
+	"azurerm_network_ddos_protection_plan":        networkRule(ResourceGroup),
+	"azurerm_network_interface":                   networkRule(ResourceGroup),
+	"azurerm_network_packet_capture":              parentScoped,
+	"azurerm_network_profile":                     networkRule(ResourceGroup),
+	"azurerm_network_security_group":              networkRule(ResourceGroup),
+	"azurerm_network_security_rule":               networkRule(Parent),
+	"azurerm_network_watcher":                     networkRule(ResourceGroup),
+	"azurerm_notification_hub":                    parentScoped,
+	"azurerm_notification_hub_authorization_rule": parentScoped,
+	"azurerm_notification_hub_namespace": {
+		MinLength:          6,
+		MaxLength:          50,
+		Pattern:            regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]*[a-zA-Z0-9]$`),
+		PatternDescription: "can only contain letters, numbers and hyphens, must start with a letter and must end with a letter or number",
+		Scope:              Global,
+	},
+	"azurerm_packet_capture":                        parentScoped,
+	"azurerm_policy_assignment":                     parentScoped,
+	"azurerm_policy_definition":                     subscriptionScoped,
+	"azurerm_policy_set_definition":                 subscriptionScoped,
+	"azurerm_postgresql_configuration":              parentScoped,
+	"azurerm_postgresql_database":                   parentScoped,
+	"azurerm_postgresql_firewall_rule":              parentScoped,
+	"azurerm_postgresql_server":                     mySqlPostgreSqlServerRule,
+	"azurerm_postgresql_virtual_network_rule":       {Validate: validate.VirtualNetworkRuleName, Scope: Parent},
+	"azurerm_private_dns_a_record":                  parentScoped,
+	"azurerm_private_dns_aaaa_record":               parentScoped,
+	"azurerm_private_dns_cname_record":              parentScoped,
+	"azurerm_private_dns_ptr_record":                parentScoped,
+	"azurerm_private_dns_srv_record":                parentScoped,
+	"azurerm_private_dns_txt_record":                parentScoped,
+	"azurerm_private_dns_zone":                      resourceGroupScoped,
+	"azurerm_private_dns_zone_virtual_network_link": parentScoped,
+	"azurerm_public_ip":                             networkRule(ResourceGroup),
+	"azurerm_public_ip_prefix":                      networkRule(ResourceGroup),
+	"azurerm_recovery_services_protection_policy_vm": {
+		MinLength:          3,
+		MaxLength:          150,
+		Pattern:            regexp.MustCompile(`^[a-zA-Z][-_!a-zA-Z0-9]+$`),
+		PatternDescription: "must start with a letter and can only contain letters, numbers, hyphens, underscores and exclamation marks",
+		Scope:              Parent,
+	},
+	"azurerm_recovery_services_vault": {
+		MinLength:          2,
+		MaxLength:          50,
+		Pattern:            regexp.MustCompile(`^[a-zA-Z][-a-zA-Z0-9]+$`),
+		PatternDescription: "must start with a letter and can only contain letters, numbers and hyphens",
+		Scope:              ResourceGroup,
+	},
+	"azurerm_redis_cache": {
+		MaxLength:          63,
+		Pattern:            regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?$`),
+		PatternDescription: "can only contain letters, numbers and hyphens, and must begin and end with a letter or number",
+		Validate:           noConsecutiveHyphens,
+		Scope:              Global,
+	},
+	"azurerm_redis_firewall_rule": {
+		Pattern:            regexp.MustCompile(`^\w+$`),
+		PatternDescription: "may only contain alphanumeric characters and underscores",
+		Scope:              Parent,
+	},
+	"azurerm_relay_namespace": {
+		MinLength: 6,
+		MaxLength: 50,
+		Scope:     Global,
+	},
+	"azurerm_resource_group": {
+		MaxLength:          80,
+		Pattern:            regexp.MustCompile(`^[-\w._()]*[-\w_()]$`),
+		PatternDescription: "may only contain alphanumeric characters, dash, underscores, parentheses and periods, and may not end with a period",
+		Scope:              Subscription,
+	},
+	"azurerm_role_assignment": {
+		Validate: validate.UUID,
+		Scope:    Global,
+	},
+	"azurerm_role_definition": subscriptionScoped,
+	"azurerm_route":           networkRule(Parent),
+	"azurerm_route_table":     networkRule(ResourceGroup),
+	"azurerm_scheduler_job": {
+		Pattern:            regexp.MustCompile(`^[a-zA-Z][-_a-zA-Z0-9].*$`),
+		PatternDescription: "must start with a letter and contain only letters, numbers, hyphens and underscores",
+		Scope:              Parent,
+	},
+	"azurerm_scheduler_job_collection": {
+		MaxLength:          100,
+		Pattern:            regexp.MustCompile(`^[a-zA-Z][-_a-zA-Z0-9]*$`),
+		PatternDescription: "must start with a letter and contain only letters, numbers, hyphens and underscores",
+		Scope:              ResourceGroup,
+	},
+	"azurerm_search_service": {
+		MinLength:          2,
+		MaxLength:          60,
+		Pattern:            regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`),
+		PatternDescription: "can only contain lowercase letters, numbers and hyphens, and must begin and end with a letter or number",
+		Validate:           noConsecutiveHyphens,
+		Scope:              Global,
+	},
+	"azurerm_service_fabric_cluster": {
+		Pattern:            regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`),
+		PatternDescription: "can only contain lowercase letters, numbers and hyphens, and must begin and end with a letter or number",
+		Scope:              Global,
+	},
+	"azurerm_servicebus_namespace": {
+		MinLength:          2,
+		MaxLength:          102,
+		Pattern:            regexp.MustCompile(`^[a-zA-Z][-a-zA-Z0-9]*[a-zA-Z0-9]$`),
+		PatternDescription: "can contain only letters, numbers, and hyphens and must start with a letter, and it must end with a letter or number",
+		Scope:              Global,
+	},
+	"azurerm_servicebus_namespace_authorization_rule": {Validate: azure.ValidateServiceBusAuthorizationRuleName(), Scope: Parent},
+	"azurerm_servicebus_queue":                        {Validate: azure.ValidateServiceBusQueueName(), Scope: Parent},
+	"azurerm_servicebus_queue_authorization_rule":     {Validate: azure.ValidateServiceBusAuthorizationRuleName(), Scope: Parent},
+	"azurerm_servicebus_subscription":                 {Validate: azure.ValidateServiceBusSubscriptionName(), Scope: Parent},
+	"azurerm_servicebus_subscription_rule": {
+		MaxLength: 50,
+		Scope:     Parent,
+	},
+	"azurerm_servicebus_topic":                    {Validate: azure.ValidateServiceBusTopicName(), Scope: Parent},
+	"azurerm_servicebus_topic_authorization_rule": {Validate: azure.ValidateServiceBusAuthorizationRuleName(), Scope: Parent},
+	"azurerm_shared_image":                        {Validate: validate.SharedImageName, Scope: Parent},
+	"azurerm_shared_image_gallery":                {Validate: validate.SharedImageGalleryName, Scope: ResourceGroup},
+	"azurerm_shared_image_version":                {Validate: validate.SharedImageVersionName, Scope: Parent},
+	"azurerm_signalr_service": {
+		MinLength:          3,
+		MaxLength:          63,
+		Pattern:            regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]*[a-zA-Z0-9]$`),
+		PatternDescription: "can only contain letters, numbers and hyphens, must start with a letter and must end with a letter or number",
+		Scope:              Global,
+	},
+	"azurerm_snapshot": {
+		MaxLength:          80,
+		Pattern:            regexp.MustCompile(`^[A-Za-z0-9_-]+$`),
+		PatternDescription: "can only contain alphanumeric characters, underscores and hyphens",
+		Scope:              ResourceGroup,
+	},
+	"azurerm_sql_database":      {Validate: azure.ValidateMsSqlDatabaseName, Scope: Parent},
+	"azurerm_sql_elasticpool":   {Validate: azure.ValidateMsSqlElasticPoolName, Scope: Parent},
+	"azurerm_sql_firewall_rule": parentScoped,
+	"azurerm_sql_server": {
+		MaxLength:          63,
+		Pattern:            regexp.MustCompile(`^[0-9a-z]([-0-9a-z]*[0-9a-z])?$`),
+		PatternDescription: "can contain only lowercase letters, numbers, and '-', but can't start or end with '-'",
+		Scope:              Global,
+	},
+	"azurerm_sql_virtual_network_rule": {
+		MaxLength:          128,
+		Pattern:            regexp.MustCompile(`^[A-Za-z]([A-Za-z0-9-]*[A-Za-z0-9])?$`),
+		PatternDescription: "can only contain alphanumeric characters and hyphens, cannot start with a number or hyphen and cannot end with a hyphen",
+		Scope:              Parent,
+	},
+	"azurerm_storage_account": {
+		MinLength:          3,
+		MaxLength:          24,
+		Pattern:            regexp.MustCompile(`^[a-z0-9]+$`),
+		PatternDescription: "can only consist of lowercase letters and numbers",
+		Scope:              Global,
+	},
+	"azurerm_storage_blob": {
+		MaxLength: 1024,
+		Scope:     Parent,
+	},
+	"azurerm_storage_container": {
+		MinLength:          3,
+		MaxLength:          63,
+		Pattern:            regexp.MustCompile(`^(\$root|[0-9a-z][0-9a-z-]*)$`),
+		PatternDescription: "can only contain lowercase alphanumeric characters and hyphens, and cannot begin with a hyphen",
+		Scope:              Parent,
+	},
+	"azurerm_storage_data_lake_gen2_filesystem": {
+		MinLength:          3,
+		MaxLength:          63,
+		Pattern:            regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`),
+		PatternDescription: "can only contain lowercase alphanumeric characters and hyphens, and cannot begin or end with a hyphen",
+		Validate:           noConsecutiveHyphens,
+		Scope:              Parent,
+	},
+	"azurerm_storage_queue": {
+		MinLength:          3,
+		MaxLength:          63,
+		Pattern:            regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`),
+		PatternDescription: "can only contain lowercase alphanumeric characters and hyphens, and cannot begin or end with a hyphen",
+		Scope:              Parent,
+	},
+	"azurerm_storage_share": {
+		MinLength:          3,
+		MaxLength:          63,
+		Pattern:            regexp.MustCompile(`^[0-9a-z][0-9a-z-]*$`),
+		PatternDescription: "can only contain lowercase alphanumeric characters and hyphens, and cannot begin with a hyphen",
+		Validate:           noConsecutiveHyphens,
+		Scope:              Parent,
+	},
+	"azurerm_storage_table": {
+		MinLength:          3,
+		MaxLength:          63,
+		Pattern:            regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*$`),
+		PatternDescription: "can only contain alphanumeric characters and cannot begin with a numeric character",
+		Validate:           storageTableReservedName,
+		Scope:              Parent,
+	},
+	"azurerm_stream_analytics_function_javascript_udf": parentScoped,
+	"azurerm_stream_analytics_job":                     resourceGroupScoped,
+	"azurerm_stream_analytics_output_blob":             parentScoped,
+	"azurerm_stream_analytics_output_eventhub":         parentScoped,
+	"azurerm_stream_analytics_output_servicebus_queue": parentScoped,
+	"azurerm_stream_analytics_stream_input_blob":       parentScoped,
+	"azurerm_stream_analytics_stream_input_eventhub":   parentScoped,
+	"azurerm_stream_analytics_stream_input_iothub":     parentScoped,
+	"azurerm_subnet": networkRule(Parent),
+	"azurerm_template_deployment": {
+		MaxLength:          64,
+		Pattern:            regexp.MustCompile(`^[-\w._()]+$`),
+		PatternDescription: "can only contain alphanumeric characters, dashes, underscores, parentheses and periods",
+		Scope:              ResourceGroup,
+	},
+	"azurerm_traffic_manager_endpoint": parentScoped,
+	"azurerm_traffic_manager_profile":  resourceGroupScoped,
+	"azurerm_user_assigned_identity": {
+		MaxLength: 24,
+		Scope:     ResourceGroup,
+	},
+	"azurerm_virtual_machine":           virtualMachineRule,
+	"azurerm_virtual_machine_extension": parentScoped,
+	"azurerm_virtual_machine_scale_set": virtualMachineRule,
+	"azurerm_virtual_network": {
+		MinLength:          2,
+		MaxLength:          64,
+		Pattern:            networkPattern,
+		PatternDescription: networkPatternDescription,
+		Scope:              ResourceGroup,
+	},
+	"azurerm_virtual_network_gateway":            networkRule(ResourceGroup),
+	"azurerm_virtual_network_gateway_connection": networkRule(ResourceGroup),
+	"azurerm_virtual_network_peering":            networkRule(Parent),
+	"azurerm_web_application_firewall_policy":    networkRule(ResourceGroup),
+}
+
+func storageTableReservedName(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "table" {
+		errors = append(errors, fmt.Errorf("%q cannot use the word `table`: %q", k, v))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,130 @@
+package recorder
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Cassette is a recording of the HTTP Interactions made during a test
+type Cassette struct {
+	// Metadata contains values which were used during the recording (e.g. the Subscription ID)
+	// and which need to be used when replaying, so that the requests match
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a single HTTP Request and the Response which was returned for it
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+
+	// replayed tracks whether this Interaction has already been used during a replay
+	replayed bool
+}
+
+// RecordedRequest is the subset of a HTTP Request needed to match it during a replay
+type RecordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// RecordedResponse is a HTTP Response which can be replayed
+type RecordedResponse struct {
+	StatusCode int                 `json:"status_code"`
+	Headers    map[string][]string `json:"headers,omitempty"`
+	Body       string              `json:"body,omitempty"`
+}
+
+// recordedResponseHeaders are the Response Headers which are persisted into the Cassette - since
+// these are the ones used by the SDK for polling & retries, everything else is discarded
+var recordedResponseHeaders = []string{
+	"Azure-AsyncOperation",
+	"Content-Type",
+	"Location",
+	"Retry-After",
+}
+
+// LoadCassette loads the Cassette stored at the specified path
+func LoadCassette(path string) (*Cassette, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading Cassette %q: %+v", path, err)
+	}
+
+	var cassette Cassette
+	if err := json.Unmarshal(contents, &cassette); err != nil {
+		return nil, fmt.Errorf("Error parsing Cassette %q: %+v", path, err)
+	}
+
+	return &cassette, nil
+}
+
+// Save writes the Cassette to the specified path, creating any parent directories
+func (c *Cassette) Save(path string) error {
+	contents, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("Error serializing Cassette: %+v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("Error creating directory for Cassette %q: %+v", path, err)
+	}
+
+	if err := ioutil.WriteFile(path, contents, 0644); err != nil {
+		return fmt.Errorf("Error writing Cassette %q: %+v", path, err)
+	}
+
+	return nil
+}
+
+func (c *Cassette) nextInteractionFor(method, url string) *Interaction {
+	for i, v := range c.Interactions {
+		if v.replayed {
+			continue
+		}
+
+		if !strings.EqualFold(v.Request.Method, method) || !urlsMatch(v.Request.URL, url) {
+			continue
+		}
+
+		c.Interactions[i].replayed = true
+		return &c.Interactions[i]
+	}
+
+	return nil
+}
+
+// urlsMatch compares the Path and Query String of the URLs case-insensitively, since Resource Manager
+// treats these as such - the Host is ignored since it differs between the real and fake API's
+func urlsMatch(recorded, actual string) bool {
+	recordedUrl, err := url.Parse(recorded)
+	if err != nil {
+		return false
+	}
+
+	actualUrl, err := url.Parse(actual)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(recordedUrl.RequestURI(), actualUrl.RequestURI())
+}
+
+func filterResponseHeaders(headers http.Header) map[string][]string {
+	out := make(map[string][]string)
+	for _, key := range recordedResponseHeaders {
+		key = http.CanonicalHeaderKey(key)
+		if values := headers[key]; len(values) > 0 {
+			out[key] = values
+		}
+	}
+	return out
+}
@@ -0,0 +1,214 @@
+package recorder
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Mode determines whether a Recorder records new Interactions or replays existing ones
+type Mode string
+
+const (
+	// Recording sends each request to the underlying Transport and records the Interaction
+	Recording Mode = "record"
+
+	// Replaying returns the recorded Response for each request without making any network calls
+	Replaying Mode = "replay"
+)
+
+// ModeFromEnvironment returns the Mode specified in the `ARM_TEST_RECORDER_MODE` Environment Variable,
+// defaulting to Replaying so that tests don't make network calls unless this is explicitly opted into
+func ModeFromEnvironment() Mode {
+	if strings.EqualFold(os.Getenv("ARM_TEST_RECORDER_MODE"), string(Recording)) {
+		return Recording
+	}
+
+	return Replaying
+}
+
+// Recorder is a http.RoundTripper which either records the Interactions sent through it into
+// a Cassette - or replays the Interactions from a previously recorded Cassette
+type Recorder struct {
+	mode      Mode
+	path      string
+	transport http.RoundTripper
+
+	lock     sync.Mutex
+	cassette *Cassette
+}
+
+// New returns a Recorder for the Cassette at the specified path. When Recording the requests
+// are sent using the specified Transport (or http.DefaultTransport if nil) - when Replaying
+// the Cassette must already exist.
+func New(path string, mode Mode, transport http.RoundTripper) (*Recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	recorder := Recorder{
+		mode:      mode,
+		path:      path,
+		transport: transport,
+	}
+
+	switch mode {
+	case Recording:
+		recorder.cassette = &Cassette{
+			Interactions: make([]Interaction, 0),
+		}
+
+	case Replaying:
+		cassette, err := LoadCassette(path)
+		if err != nil {
+			return nil, err
+		}
+		recorder.cassette = cassette
+
+	default:
+		return nil, fmt.Errorf("Unsupported Recorder Mode %q", string(mode))
+	}
+
+	return &recorder, nil
+}
+
+// RoundTrip implements the http.RoundTripper interface
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	requestBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.mode == Replaying {
+		return r.replay(req)
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil || !shouldRecord(req) {
+		return resp, err
+	}
+
+	responseBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("Error reading Response Body for %s %s: %+v", req.Method, req.URL, err)
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	// NOTE: the Request Headers are intentionally not recorded, since they contain the Authorization token
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Body:   string(requestBody),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    filterResponseHeaders(resp.Header),
+			Body:       string(responseBody),
+		},
+	})
+
+	return resp, nil
+}
+
+// Metadata returns the value of the specified key from the Cassette's Metadata
+func (r *Recorder) Metadata(key string) string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.cassette.Metadata[key]
+}
+
+// SetMetadata sets the value of the specified key within the Cassette's Metadata
+func (r *Recorder) SetMetadata(key, value string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.cassette.Metadata == nil {
+		r.cassette.Metadata = make(map[string]string)
+	}
+	r.cassette.Metadata[key] = value
+}
+
+// Stop saves the Cassette when Recording - and is a no-op when Replaying
+func (r *Recorder) Stop() error {
+	if r.mode != Recording {
+		return nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.cassette.Save(r.path)
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	interaction := r.cassette.nextInteractionFor(req.Method, req.URL.String())
+	if interaction == nil {
+		return nil, fmt.Errorf("No recorded Interaction was found for %s %s in Cassette %q", req.Method, req.URL, r.path)
+	}
+
+	log.Printf("[DEBUG] Replaying recorded Interaction for %s %s", req.Method, req.URL)
+
+	recorded := interaction.Response
+	headers := copyHeaders(recorded.Headers)
+
+	// there's no need to wait between polling requests when replaying, since the recorded
+	// responses are returned immediately
+	if headers.Get("Retry-After") != "" {
+		headers.Set("Retry-After", "0")
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        headers,
+		Body:          ioutil.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}, nil
+}
+
+// shouldRecord determines whether the Interaction for this request should be recorded - requests for
+// an Access Token are never recorded since they contain the credentials used to authenticate
+func shouldRecord(req *http.Request) bool {
+	return !strings.Contains(strings.ToLower(req.URL.Path), "/oauth2/")
+}
+
+func copyHeaders(input map[string][]string) http.Header {
+	headers := make(http.Header)
+	for k, v := range input {
+		headers[k] = append([]string{}, v...)
+	}
+	return headers
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("Error reading Request Body for %s %s: %+v", req.Method, req.URL, err)
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
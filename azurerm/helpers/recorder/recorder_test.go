@@ -0,0 +1,147 @@
+package recorder
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Azure-AsyncOperation", "https://example.com/operations/1")
+		w.Header().Set("Retry-After", "15")
+		w.Header().Set("X-Ms-Request-Id", "should-not-be-recorded")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"method":%q}`, r.Method)
+	}))
+	defer server.Close()
+
+	directory, err := ioutil.TempDir("", "recorder")
+	if err != nil {
+		t.Fatalf("Error creating temporary directory: %+v", err)
+	}
+	defer os.RemoveAll(directory)
+	path := filepath.Join(directory, "nested", "cassette.json")
+
+	recording, err := New(path, Recording, nil)
+	if err != nil {
+		t.Fatalf("Error creating Recorder: %+v", err)
+	}
+	recording.SetMetadata("subscription_id", "00000000-0000-0000-0000-000000000000")
+
+	client := &http.Client{Transport: recording}
+	for _, v := range []string{http.MethodPut, http.MethodGet} {
+		if _, err := doRequest(client, v, server.URL+"/subscriptions/1/resourceGroups/group1?api-version=2018-05-01"); err != nil {
+			t.Fatalf("Error recording %s request: %+v", v, err)
+		}
+	}
+	if _, err := doRequest(client, http.MethodPost, server.URL+"/tenant/oauth2/token"); err != nil {
+		t.Fatalf("Error recording token request: %+v", err)
+	}
+
+	if err := recording.Stop(); err != nil {
+		t.Fatalf("Error saving Cassette: %+v", err)
+	}
+
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		t.Fatalf("Error loading Cassette: %+v", err)
+	}
+	if len(cassette.Interactions) != 2 {
+		t.Fatalf("Expected 2 Interactions to be recorded but got %d", len(cassette.Interactions))
+	}
+	if _, ok := cassette.Interactions[0].Response.Headers["X-Ms-Request-Id"]; ok {
+		t.Fatalf("Expected the `X-Ms-Request-Id` header not to be recorded")
+	}
+
+	replaying, err := New(path, Replaying, nil)
+	if err != nil {
+		t.Fatalf("Error creating Recorder: %+v", err)
+	}
+	if v := replaying.Metadata("subscription_id"); v != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected the Subscription ID to be replayed but got %q", v)
+	}
+
+	// the server is closed to ensure that no network calls are made
+	server.Close()
+
+	client = &http.Client{Transport: replaying}
+	resp, err := doRequest(client, http.MethodPut, "https://management.azure.com/SUBSCRIPTIONS/1/resourcegroups/group1?api-version=2018-05-01")
+	if err != nil {
+		t.Fatalf("Error replaying PUT request: %+v", err)
+	}
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected the Status Code to be %d but got %d", http.StatusCreated, resp.StatusCode)
+	}
+	if v := resp.Header.Get("Azure-AsyncOperation"); v != "https://example.com/operations/1" {
+		t.Fatalf("Expected the `Azure-AsyncOperation` header to be replayed but got %q", v)
+	}
+	if v := resp.Header.Get("Retry-After"); v != "0" {
+		t.Fatalf("Expected the `Retry-After` header to be replayed as `0` but got %q", v)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	if !strings.Contains(string(body), `"PUT"`) {
+		t.Fatalf("Expected the Body for the PUT request but got %q", string(body))
+	}
+
+	if _, err := doRequest(client, http.MethodGet, "https://management.azure.com/subscriptions/1/resourceGroups/group1?api-version=2018-05-01"); err != nil {
+		t.Fatalf("Error replaying GET request: %+v", err)
+	}
+
+	// each Interaction can only be replayed once
+	if _, err := doRequest(client, http.MethodGet, "https://management.azure.com/subscriptions/1/resourceGroups/group1?api-version=2018-05-01"); err == nil {
+		t.Fatalf("Expected an error replaying an Interaction which wasn't recorded")
+	}
+}
+
+func TestRecorderReplayingMissingCassette(t *testing.T) {
+	if _, err := New(filepath.Join("does", "not", "exist.json"), Replaying, nil); err == nil {
+		t.Fatalf("Expected an error when the Cassette doesn't exist")
+	}
+}
+
+func TestURLsMatch(t *testing.T) {
+	testData := []struct {
+		Recorded string
+		Actual   string
+		Expected bool
+	}{
+		{
+			Recorded: "https://management.azure.com/subscriptions/1/resourceGroups/group1?api-version=2018-05-01",
+			Actual:   "http://127.0.0.1:1234/subscriptions/1/resourcegroups/GROUP1?api-version=2018-05-01",
+			Expected: true,
+		},
+		{
+			Recorded: "https://management.azure.com/subscriptions/1/resourceGroups/group1?api-version=2018-05-01",
+			Actual:   "https://management.azure.com/subscriptions/1/resourceGroups/group1?api-version=2019-01-01",
+			Expected: false,
+		},
+		{
+			Recorded: "https://management.azure.com/subscriptions/1/resourceGroups/group1",
+			Actual:   "https://management.azure.com/subscriptions/1/resourceGroups/group2",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q against %q", v.Actual, v.Recorded)
+
+		if actual := urlsMatch(v.Recorded, v.Actual); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func doRequest(client *http.Client, method, url string) (*http.Response, error) {
+	req, err := http.NewRequest(method, url, strings.NewReader("{}"))
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Do(req)
+}
@@ -0,0 +1,71 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type AppServiceId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewAppServiceID(subscriptionId, resourceGroup, name string) AppServiceId {
+	return AppServiceId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id AppServiceId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseAppServiceID parses a App Service ID into a AppServiceId struct
+func ParseAppServiceID(input string) (*AppServiceId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a App Service ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Web") {
+		return nil, fmt.Errorf("parsing %q as a App Service ID: expected the provider %q but got %q", input, "Microsoft.Web", id.Provider)
+	}
+
+	resourceId := AppServiceId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("sites"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAppServiceID validates that the specified value is a App Service ID
+func ValidateAppServiceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParseAppServiceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a App Service ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,71 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type AppServicePlanId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewAppServicePlanID(subscriptionId, resourceGroup, name string) AppServicePlanId {
+	return AppServicePlanId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id AppServicePlanId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/serverfarms/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseAppServicePlanID parses a App Service Plan ID into a AppServicePlanId struct
+func ParseAppServicePlanID(input string) (*AppServicePlanId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a App Service Plan ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Web") {
+		return nil, fmt.Errorf("parsing %q as a App Service Plan ID: expected the provider %q but got %q", input, "Microsoft.Web", id.Provider)
+	}
+
+	resourceId := AppServicePlanId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("serverfarms"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAppServicePlanID validates that the specified value is a App Service Plan ID
+func ValidateAppServicePlanID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParseAppServicePlanID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a App Service Plan ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
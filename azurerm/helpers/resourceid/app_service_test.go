@@ -0,0 +1,107 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import "testing"
+
+func TestAppServiceIDFormatter(t *testing.T) {
+	actual := NewAppServiceID("11111111-1111-1111-1111-111111111111", "group1", "site1").ID()
+	expected := "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Web/sites/site1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestAppServiceID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *AppServiceId
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/11111111-1111-1111-1111-111111111111/",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Web/",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Web/sites/",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Web/sites/site1",
+			Expected: &AppServiceId{
+				SubscriptionId: "11111111-1111-1111-1111-111111111111",
+				ResourceGroup:  "group1",
+				Name:           "site1",
+			},
+		},
+		{
+			Input: "/SUBSCRIPTIONS/11111111-1111-1111-1111-111111111111/RESOURCEGROUPS/group1/PROVIDERS/Microsoft.Web/SITES/site1",
+			Expected: &AppServiceId{
+				SubscriptionId: "11111111-1111-1111-1111-111111111111",
+				ResourceGroup:  "group1",
+				Name:           "site1",
+			},
+		},
+		{
+			Input: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Web/sites/site1/extra/segment",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseAppServiceID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatal("Expected an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+
+		if _, errors := ValidateAppServiceID(v.Input, "example"); len(errors) != 0 {
+			t.Fatalf("Expected no validation errors but got: %+v", errors)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type ApplicationGatewayId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewApplicationGatewayID(subscriptionId, resourceGroup, name string) ApplicationGatewayId {
+	return ApplicationGatewayId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id ApplicationGatewayId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/applicationGateways/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseApplicationGatewayID parses a Application Gateway ID into a ApplicationGatewayId struct
+func ParseApplicationGatewayID(input string) (*ApplicationGatewayId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Application Gateway ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("parsing %q as a Application Gateway ID: expected the provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := ApplicationGatewayId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("applicationGateways"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApplicationGatewayID validates that the specified value is a Application Gateway ID
+func ValidateApplicationGatewayID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParseApplicationGatewayID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Application Gateway ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
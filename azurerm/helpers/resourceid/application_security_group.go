@@ -0,0 +1,71 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type ApplicationSecurityGroupId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewApplicationSecurityGroupID(subscriptionId, resourceGroup, name string) ApplicationSecurityGroupId {
+	return ApplicationSecurityGroupId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id ApplicationSecurityGroupId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/applicationSecurityGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseApplicationSecurityGroupID parses a Application Security Group ID into a ApplicationSecurityGroupId struct
+func ParseApplicationSecurityGroupID(input string) (*ApplicationSecurityGroupId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Application Security Group ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("parsing %q as a Application Security Group ID: expected the provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := ApplicationSecurityGroupId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("applicationSecurityGroups"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateApplicationSecurityGroupID validates that the specified value is a Application Security Group ID
+func ValidateApplicationSecurityGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParseApplicationSecurityGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Application Security Group ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
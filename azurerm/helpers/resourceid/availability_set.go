@@ -0,0 +1,71 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type AvailabilitySetId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewAvailabilitySetID(subscriptionId, resourceGroup, name string) AvailabilitySetId {
+	return AvailabilitySetId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id AvailabilitySetId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/availabilitySets/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseAvailabilitySetID parses a Availability Set ID into a AvailabilitySetId struct
+func ParseAvailabilitySetID(input string) (*AvailabilitySetId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Availability Set ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("parsing %q as a Availability Set ID: expected the provider %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := AvailabilitySetId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("availabilitySets"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateAvailabilitySetID validates that the specified value is a Availability Set ID
+func ValidateAvailabilitySetID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParseAvailabilitySetID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Availability Set ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,71 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type ContainerRegistryId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewContainerRegistryID(subscriptionId, resourceGroup, name string) ContainerRegistryId {
+	return ContainerRegistryId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id ContainerRegistryId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerRegistry/registries/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseContainerRegistryID parses a Container Registry ID into a ContainerRegistryId struct
+func ParseContainerRegistryID(input string) (*ContainerRegistryId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Container Registry ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ContainerRegistry") {
+		return nil, fmt.Errorf("parsing %q as a Container Registry ID: expected the provider %q but got %q", input, "Microsoft.ContainerRegistry", id.Provider)
+	}
+
+	resourceId := ContainerRegistryId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("registries"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateContainerRegistryID validates that the specified value is a Container Registry ID
+func ValidateContainerRegistryID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParseContainerRegistryID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Container Registry ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
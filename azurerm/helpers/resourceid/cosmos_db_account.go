@@ -0,0 +1,71 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type CosmosDBAccountId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewCosmosDBAccountID(subscriptionId, resourceGroup, name string) CosmosDBAccountId {
+	return CosmosDBAccountId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id CosmosDBAccountId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DocumentDB/databaseAccounts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseCosmosDBAccountID parses a Cosmos DB Account ID into a CosmosDBAccountId struct
+func ParseCosmosDBAccountID(input string) (*CosmosDBAccountId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Cosmos DB Account ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.DocumentDB") {
+		return nil, fmt.Errorf("parsing %q as a Cosmos DB Account ID: expected the provider %q but got %q", input, "Microsoft.DocumentDB", id.Provider)
+	}
+
+	resourceId := CosmosDBAccountId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("databaseAccounts"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateCosmosDBAccountID validates that the specified value is a Cosmos DB Account ID
+func ValidateCosmosDBAccountID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParseCosmosDBAccountID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Cosmos DB Account ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,71 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type DdosProtectionPlanId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewDdosProtectionPlanID(subscriptionId, resourceGroup, name string) DdosProtectionPlanId {
+	return DdosProtectionPlanId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id DdosProtectionPlanId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/ddosProtectionPlans/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseDdosProtectionPlanID parses a Ddos Protection Plan ID into a DdosProtectionPlanId struct
+func ParseDdosProtectionPlanID(input string) (*DdosProtectionPlanId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Ddos Protection Plan ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("parsing %q as a Ddos Protection Plan ID: expected the provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := DdosProtectionPlanId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("ddosProtectionPlans"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDdosProtectionPlanID validates that the specified value is a Ddos Protection Plan ID
+func ValidateDdosProtectionPlanID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParseDdosProtectionPlanID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Ddos Protection Plan ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
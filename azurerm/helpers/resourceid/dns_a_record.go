@@ -0,0 +1,77 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type DnsARecordId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	DnsZoneName    string
+	Name           string
+}
+
+func NewDnsARecordID(subscriptionId, resourceGroup, dnsZoneName, name string) DnsARecordId {
+	return DnsARecordId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		DnsZoneName:    dnsZoneName,
+		Name:           name,
+	}
+}
+
+func (id DnsARecordId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnsZones/%s/A/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnsZoneName, id.Name)
+}
+
+// ParseDnsARecordID parses a Dns A Record ID into a DnsARecordId struct
+func ParseDnsARecordID(input string) (*DnsARecordId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Dns A Record ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("parsing %q as a Dns A Record ID: expected the provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := DnsARecordId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.DnsZoneName, err = id.PopSegment("dnsZones"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("A"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDnsARecordID validates that the specified value is a Dns A Record ID
+func ValidateDnsARecordID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParseDnsARecordID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Dns A Record ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
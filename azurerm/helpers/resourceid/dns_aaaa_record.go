@@ -0,0 +1,77 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type DnsAaaaRecordId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	DnsZoneName    string
+	Name           string
+}
+
+func NewDnsAaaaRecordID(subscriptionId, resourceGroup, dnsZoneName, name string) DnsAaaaRecordId {
+	return DnsAaaaRecordId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		DnsZoneName:    dnsZoneName,
+		Name:           name,
+	}
+}
+
+func (id DnsAaaaRecordId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnsZones/%s/AAAA/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnsZoneName, id.Name)
+}
+
+// ParseDnsAaaaRecordID parses a Dns Aaaa Record ID into a DnsAaaaRecordId struct
+func ParseDnsAaaaRecordID(input string) (*DnsAaaaRecordId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Dns Aaaa Record ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("parsing %q as a Dns Aaaa Record ID: expected the provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := DnsAaaaRecordId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.DnsZoneName, err = id.PopSegment("dnsZones"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("AAAA"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDnsAaaaRecordID validates that the specified value is a Dns Aaaa Record ID
+func ValidateDnsAaaaRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParseDnsAaaaRecordID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Dns Aaaa Record ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
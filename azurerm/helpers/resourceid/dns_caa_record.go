@@ -0,0 +1,77 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type DnsCaaRecordId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	DnsZoneName    string
+	Name           string
+}
+
+func NewDnsCaaRecordID(subscriptionId, resourceGroup, dnsZoneName, name string) DnsCaaRecordId {
+	return DnsCaaRecordId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		DnsZoneName:    dnsZoneName,
+		Name:           name,
+	}
+}
+
+func (id DnsCaaRecordId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnsZones/%s/CAA/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnsZoneName, id.Name)
+}
+
+// ParseDnsCaaRecordID parses a Dns Caa Record ID into a DnsCaaRecordId struct
+func ParseDnsCaaRecordID(input string) (*DnsCaaRecordId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Dns Caa Record ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("parsing %q as a Dns Caa Record ID: expected the provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := DnsCaaRecordId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.DnsZoneName, err = id.PopSegment("dnsZones"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("CAA"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDnsCaaRecordID validates that the specified value is a Dns Caa Record ID
+func ValidateDnsCaaRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParseDnsCaaRecordID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Dns Caa Record ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,77 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type DnsMxRecordId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	DnsZoneName    string
+	Name           string
+}
+
+func NewDnsMxRecordID(subscriptionId, resourceGroup, dnsZoneName, name string) DnsMxRecordId {
+	return DnsMxRecordId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		DnsZoneName:    dnsZoneName,
+		Name:           name,
+	}
+}
+
+func (id DnsMxRecordId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnsZones/%s/MX/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnsZoneName, id.Name)
+}
+
+// ParseDnsMxRecordID parses a Dns Mx Record ID into a DnsMxRecordId struct
+func ParseDnsMxRecordID(input string) (*DnsMxRecordId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Dns Mx Record ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("parsing %q as a Dns Mx Record ID: expected the provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := DnsMxRecordId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.DnsZoneName, err = id.PopSegment("dnsZones"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("MX"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateDnsMxRecordID validates that the specified value is a Dns Mx Record ID
+func ValidateDnsMxRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParseDnsMxRecordID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Dns Mx Record ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
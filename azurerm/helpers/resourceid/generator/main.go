@@ -0,0 +1,382 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// This tool generates a strongly typed Resource ID (with a Formatter, Parser and
+// Validation function) for a given Azure Resource ID, for example:
+//
+//   go run ./generator -name=Subnet -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1
+//
+// The Resource ID must be a valid example of the ID - since it's used to determine
+// the segments in the ID and as test data for the generated tests.
+
+func main() {
+	name := flag.String("name", "", "The name of the Resource ID Type, e.g. `Subnet`")
+	id := flag.String("id", "", "An example Resource ID, used to determine the segments")
+	path := flag.String("path", ".", "The directory which the files should be output into")
+	flag.Parse()
+
+	if *name == "" || *id == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	resourceId, err := newResourceIdDefinition(*name, *id)
+	if err != nil {
+		log.Fatalf("parsing Resource ID %q: %+v", *id, err)
+	}
+
+	fileName := toSnakeCase(*name)
+	if err := writeTemplate(filepath.Join(*path, fileName+".go"), resourceIdTemplate, resourceId); err != nil {
+		log.Fatalf("generating Resource ID %q: %+v", *name, err)
+	}
+
+	if err := writeTemplate(filepath.Join(*path, fileName+"_test.go"), resourceIdTestTemplate, resourceId); err != nil {
+		log.Fatalf("generating tests for Resource ID %q: %+v", *name, err)
+	}
+}
+
+type resourceIdSegment struct {
+	// FieldName is the name of the field within the Resource ID struct
+	FieldName string
+
+	// SegmentKey is the key of this segment within the Resource ID, e.g. `virtualNetworks`
+	SegmentKey string
+
+	// ExampleValue is the value of this segment within the example Resource ID
+	ExampleValue string
+}
+
+type resourceIdDefinition struct {
+	TypeName    string
+	HumanName   string
+	ExampleID   string
+	Provider    string
+	Segments    []resourceIdSegment
+	IDFmtString string
+}
+
+func newResourceIdDefinition(typeName, id string) (*resourceIdDefinition, error) {
+	components := strings.Split(strings.Trim(id, "/"), "/")
+	if len(components)%2 != 0 {
+		return nil, fmt.Errorf("the number of segments must be divisible by 2")
+	}
+
+	definition := resourceIdDefinition{
+		TypeName:  typeName,
+		HumanName: toHumanName(typeName),
+		ExampleID: id,
+	}
+
+	fmtString := ""
+	for i := 0; i < len(components); i += 2 {
+		key := components[i]
+		value := components[i+1]
+		isLast := i+2 == len(components)
+
+		switch {
+		case i == 0:
+			if key != "subscriptions" {
+				return nil, fmt.Errorf("the first segment must be `subscriptions`")
+			}
+			definition.Segments = append(definition.Segments, resourceIdSegment{
+				FieldName:    "SubscriptionId",
+				SegmentKey:   key,
+				ExampleValue: value,
+			})
+
+		case key == "resourceGroups" && !isLast:
+			definition.Segments = append(definition.Segments, resourceIdSegment{
+				FieldName:    "ResourceGroup",
+				SegmentKey:   key,
+				ExampleValue: value,
+			})
+
+		case key == "providers":
+			definition.Provider = value
+			fmtString += fmt.Sprintf("/%s/%s", key, value)
+			continue
+
+		default:
+			fieldName := "Name"
+			if !isLast {
+				fieldName = fmt.Sprintf("%sName", toSingular(strings.Title(key)))
+			}
+			definition.Segments = append(definition.Segments, resourceIdSegment{
+				FieldName:    fieldName,
+				SegmentKey:   key,
+				ExampleValue: value,
+			})
+		}
+
+		fmtString += fmt.Sprintf("/%s/%%s", key)
+	}
+
+	if definition.Segments[len(definition.Segments)-1].FieldName != "Name" {
+		return nil, fmt.Errorf("the ID must end with a named segment")
+	}
+
+	definition.IDFmtString = fmtString
+	return &definition, nil
+}
+
+// SegmentsExcludingSubscriptionAndResourceGroup returns the segments which need to be popped from the Path
+func (d resourceIdDefinition) SegmentsExcludingSubscriptionAndResourceGroup() []resourceIdSegment {
+	out := make([]resourceIdSegment, 0)
+	for _, segment := range d.Segments {
+		if segment.FieldName == "SubscriptionId" || segment.FieldName == "ResourceGroup" {
+			continue
+		}
+		if segment.SegmentKey == "resourceGroups" {
+			continue
+		}
+		out = append(out, segment)
+	}
+	return out
+}
+
+// IsResourceGroup returns whether this Resource ID represents a Resource Group
+func (d resourceIdDefinition) IsResourceGroup() bool {
+	return len(d.Segments) == 2 && d.Segments[1].SegmentKey == "resourceGroups"
+}
+
+// TruncatedIDs returns the IDs which are missing one or more trailing segments, which must fail to parse
+func (d resourceIdDefinition) TruncatedIDs() []string {
+	components := strings.Split(strings.Trim(d.ExampleID, "/"), "/")
+	out := make([]string, 0)
+	for i := 1; i < len(components); i++ {
+		out = append(out, "/"+strings.Join(components[0:i], "/")+"/")
+	}
+	return out
+}
+
+// UpperCasedKeysID returns the Example ID with each of the keys upper-cased, which must be parsed
+func (d resourceIdDefinition) UpperCasedKeysID() string {
+	components := strings.Split(strings.Trim(d.ExampleID, "/"), "/")
+	for i := 0; i < len(components); i += 2 {
+		components[i] = strings.ToUpper(components[i])
+	}
+	return "/" + strings.Join(components, "/")
+}
+
+// ArgumentName returns the name of the argument for this segment within the constructor, e.g. `virtualNetworkName`
+func (s resourceIdSegment) ArgumentName() string {
+	return strings.ToLower(s.FieldName[0:1]) + s.FieldName[1:]
+}
+
+func toHumanName(input string) string {
+	out := ""
+	runes := []rune(input)
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			out += " "
+		}
+		out += string(r)
+	}
+	return out
+}
+
+func toSingular(input string) string {
+	switch {
+	case strings.HasSuffix(input, "ies"):
+		return strings.TrimSuffix(input, "ies") + "y"
+	case strings.HasSuffix(input, "sses"):
+		return strings.TrimSuffix(input, "es")
+	case strings.HasSuffix(input, "s"):
+		return strings.TrimSuffix(input, "s")
+	}
+	return input
+}
+
+func toSnakeCase(input string) string {
+	return strings.ToLower(strings.Replace(toHumanName(input), " ", "_", -1))
+}
+
+func writeTemplate(fileName, contents string, definition *resourceIdDefinition) error {
+	tmpl, err := template.New(fileName).Parse(contents)
+	if err != nil {
+		return fmt.Errorf("parsing template: %+v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, definition); err != nil {
+		return fmt.Errorf("executing template: %+v", err)
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting output: %+v", err)
+	}
+
+	return ioutil.WriteFile(fileName, formatted, 0644)
+}
+
+const resourceIdTemplate = `package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+{{- if .Provider }}
+	"strings"
+{{- end }}
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type {{ .TypeName }}Id struct {
+{{- range .Segments }}
+	{{ .FieldName }} string
+{{- end }}
+}
+
+func New{{ .TypeName }}ID({{ range $i, $s := .Segments }}{{ if $i }}, {{ end }}{{ $s.ArgumentName }}{{ end }} string) {{ .TypeName }}Id {
+	return {{ .TypeName }}Id{
+{{- range .Segments }}
+		{{ .FieldName }}: {{ .ArgumentName }},
+{{- end }}
+	}
+}
+
+func (id {{ .TypeName }}Id) ID() string {
+	fmtString := "{{ .IDFmtString }}"
+	return fmt.Sprintf(fmtString{{ range .Segments }}, id.{{ .FieldName }}{{ end }})
+}
+
+// Parse{{ .TypeName }}ID parses a {{ .HumanName }} ID into a {{ .TypeName }}Id struct
+func Parse{{ .TypeName }}ID(input string) (*{{ .TypeName }}Id, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a {{ .HumanName }} ID: %+v", input, err)
+	}
+{{ if .Provider }}
+	if !strings.EqualFold(id.Provider, "{{ .Provider }}") {
+		return nil, fmt.Errorf("parsing %q as a {{ .HumanName }} ID: expected the provider %q but got %q", input, "{{ .Provider }}", id.Provider)
+	}
+{{ end }}
+	resourceId := {{ .TypeName }}Id{
+		SubscriptionId: id.SubscriptionID,
+{{- if .IsResourceGroup }}
+		Name:           id.ResourceGroup,
+{{- else }}
+		ResourceGroup:  id.ResourceGroup,
+{{- end }}
+	}
+{{ range .SegmentsExcludingSubscriptionAndResourceGroup }}
+	if resourceId.{{ .FieldName }}, err = id.PopSegment("{{ .SegmentKey }}"); err != nil {
+		return nil, err
+	}
+{{ end }}
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// Validate{{ .TypeName }}ID validates that the specified value is a {{ .HumanName }} ID
+func Validate{{ .TypeName }}ID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := Parse{{ .TypeName }}ID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a {{ .HumanName }} ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
+`
+
+const resourceIdTestTemplate = `package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import "testing"
+
+func Test{{ .TypeName }}IDFormatter(t *testing.T) {
+	actual := New{{ .TypeName }}ID({{ range $i, $s := .Segments }}{{ if $i }}, {{ end }}"{{ $s.ExampleValue }}"{{ end }}).ID()
+	expected := "{{ .ExampleID }}"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func Test{{ .TypeName }}ID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *{{ .TypeName }}Id
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+{{- range .TruncatedIDs }}
+		{
+			Input: "{{ . }}",
+			Error: true,
+		},
+{{- end }}
+		{
+			Input: "{{ .ExampleID }}",
+			Expected: &{{ .TypeName }}Id{
+{{- range .Segments }}
+				{{ .FieldName }}: "{{ .ExampleValue }}",
+{{- end }}
+			},
+		},
+		{
+			Input: "{{ .UpperCasedKeysID }}",
+			Expected: &{{ .TypeName }}Id{
+{{- range .Segments }}
+				{{ .FieldName }}: "{{ .ExampleValue }}",
+{{- end }}
+			},
+		},
+		{
+			Input: "{{ .ExampleID }}/extra/segment",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := Parse{{ .TypeName }}ID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatal("Expected an error but didn't get one")
+		}
+{{ range .Segments }}
+		if actual.{{ .FieldName }} != v.Expected.{{ .FieldName }} {
+			t.Fatalf("Expected %q but got %q for {{ .FieldName }}", v.Expected.{{ .FieldName }}, actual.{{ .FieldName }})
+		}
+{{ end }}
+		if _, errors := Validate{{ .TypeName }}ID(v.Input, "example"); len(errors) != 0 {
+			t.Fatalf("Expected no validation errors but got: %+v", errors)
+		}
+	}
+}
+`
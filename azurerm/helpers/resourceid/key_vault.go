@@ -0,0 +1,71 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type KeyVaultId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewKeyVaultID(subscriptionId, resourceGroup, name string) KeyVaultId {
+	return KeyVaultId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id KeyVaultId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.KeyVault/vaults/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseKeyVaultID parses a Key Vault ID into a KeyVaultId struct
+func ParseKeyVaultID(input string) (*KeyVaultId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Key Vault ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.KeyVault") {
+		return nil, fmt.Errorf("parsing %q as a Key Vault ID: expected the provider %q but got %q", input, "Microsoft.KeyVault", id.Provider)
+	}
+
+	resourceId := KeyVaultId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("vaults"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateKeyVaultID validates that the specified value is a Key Vault ID
+func ValidateKeyVaultID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParseKeyVaultID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Key Vault ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,71 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type KubernetesClusterId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewKubernetesClusterID(subscriptionId, resourceGroup, name string) KubernetesClusterId {
+	return KubernetesClusterId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id KubernetesClusterId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/managedClusters/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseKubernetesClusterID parses a Kubernetes Cluster ID into a KubernetesClusterId struct
+func ParseKubernetesClusterID(input string) (*KubernetesClusterId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Kubernetes Cluster ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ContainerService") {
+		return nil, fmt.Errorf("parsing %q as a Kubernetes Cluster ID: expected the provider %q but got %q", input, "Microsoft.ContainerService", id.Provider)
+	}
+
+	resourceId := KubernetesClusterId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("managedClusters"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateKubernetesClusterID validates that the specified value is a Kubernetes Cluster ID
+func ValidateKubernetesClusterID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParseKubernetesClusterID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Kubernetes Cluster ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
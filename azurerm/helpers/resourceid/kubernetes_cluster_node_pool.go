@@ -0,0 +1,77 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type KubernetesClusterNodePoolId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	ManagedClusterName string
+	Name               string
+}
+
+func NewKubernetesClusterNodePoolID(subscriptionId, resourceGroup, managedClusterName, name string) KubernetesClusterNodePoolId {
+	return KubernetesClusterNodePoolId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		ManagedClusterName: managedClusterName,
+		Name:               name,
+	}
+}
+
+func (id KubernetesClusterNodePoolId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/managedClusters/%s/agentPools/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ManagedClusterName, id.Name)
+}
+
+// ParseKubernetesClusterNodePoolID parses a Kubernetes Cluster Node Pool ID into a KubernetesClusterNodePoolId struct
+func ParseKubernetesClusterNodePoolID(input string) (*KubernetesClusterNodePoolId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Kubernetes Cluster Node Pool ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ContainerService") {
+		return nil, fmt.Errorf("parsing %q as a Kubernetes Cluster Node Pool ID: expected the provider %q but got %q", input, "Microsoft.ContainerService", id.Provider)
+	}
+
+	resourceId := KubernetesClusterNodePoolId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.ManagedClusterName, err = id.PopSegment("managedClusters"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("agentPools"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateKubernetesClusterNodePoolID validates that the specified value is a Kubernetes Cluster Node Pool ID
+func ValidateKubernetesClusterNodePoolID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParseKubernetesClusterNodePoolID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Kubernetes Cluster Node Pool ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
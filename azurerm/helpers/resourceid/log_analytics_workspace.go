@@ -0,0 +1,71 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type LogAnalyticsWorkspaceId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewLogAnalyticsWorkspaceID(subscriptionId, resourceGroup, name string) LogAnalyticsWorkspaceId {
+	return LogAnalyticsWorkspaceId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id LogAnalyticsWorkspaceId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseLogAnalyticsWorkspaceID parses a Log Analytics Workspace ID into a LogAnalyticsWorkspaceId struct
+func ParseLogAnalyticsWorkspaceID(input string) (*LogAnalyticsWorkspaceId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Log Analytics Workspace ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.OperationalInsights") {
+		return nil, fmt.Errorf("parsing %q as a Log Analytics Workspace ID: expected the provider %q but got %q", input, "Microsoft.OperationalInsights", id.Provider)
+	}
+
+	resourceId := LogAnalyticsWorkspaceId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("workspaces"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateLogAnalyticsWorkspaceID validates that the specified value is a Log Analytics Workspace ID
+func ValidateLogAnalyticsWorkspaceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParseLogAnalyticsWorkspaceID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Log Analytics Workspace ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,71 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type ManagedDiskId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewManagedDiskID(subscriptionId, resourceGroup, name string) ManagedDiskId {
+	return ManagedDiskId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id ManagedDiskId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/disks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseManagedDiskID parses a Managed Disk ID into a ManagedDiskId struct
+func ParseManagedDiskID(input string) (*ManagedDiskId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Managed Disk ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("parsing %q as a Managed Disk ID: expected the provider %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := ManagedDiskId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("disks"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateManagedDiskID validates that the specified value is a Managed Disk ID
+func ValidateManagedDiskID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParseManagedDiskID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Managed Disk ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
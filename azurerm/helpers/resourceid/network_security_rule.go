@@ -0,0 +1,77 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type NetworkSecurityRuleId struct {
+	SubscriptionId           string
+	ResourceGroup            string
+	NetworkSecurityGroupName string
+	Name                     string
+}
+
+func NewNetworkSecurityRuleID(subscriptionId, resourceGroup, networkSecurityGroupName, name string) NetworkSecurityRuleId {
+	return NetworkSecurityRuleId{
+		SubscriptionId:           subscriptionId,
+		ResourceGroup:            resourceGroup,
+		NetworkSecurityGroupName: networkSecurityGroupName,
+		Name:                     name,
+	}
+}
+
+func (id NetworkSecurityRuleId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkSecurityGroups/%s/securityRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NetworkSecurityGroupName, id.Name)
+}
+
+// ParseNetworkSecurityRuleID parses a Network Security Rule ID into a NetworkSecurityRuleId struct
+func ParseNetworkSecurityRuleID(input string) (*NetworkSecurityRuleId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Network Security Rule ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("parsing %q as a Network Security Rule ID: expected the provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := NetworkSecurityRuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.NetworkSecurityGroupName, err = id.PopSegment("networkSecurityGroups"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("securityRules"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateNetworkSecurityRuleID validates that the specified value is a Network Security Rule ID
+func ValidateNetworkSecurityRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParseNetworkSecurityRuleID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Network Security Rule ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
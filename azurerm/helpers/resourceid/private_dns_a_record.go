@@ -0,0 +1,77 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type PrivateDnsARecordId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	PrivateDnsZoneName string
+	Name               string
+}
+
+func NewPrivateDnsARecordID(subscriptionId, resourceGroup, privateDnsZoneName, name string) PrivateDnsARecordId {
+	return PrivateDnsARecordId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		PrivateDnsZoneName: privateDnsZoneName,
+		Name:               name,
+	}
+}
+
+func (id PrivateDnsARecordId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/privateDnsZones/%s/A/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.PrivateDnsZoneName, id.Name)
+}
+
+// ParsePrivateDnsARecordID parses a Private Dns A Record ID into a PrivateDnsARecordId struct
+func ParsePrivateDnsARecordID(input string) (*PrivateDnsARecordId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Private Dns A Record ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("parsing %q as a Private Dns A Record ID: expected the provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := PrivateDnsARecordId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.PrivateDnsZoneName, err = id.PopSegment("privateDnsZones"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("A"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidatePrivateDnsARecordID validates that the specified value is a Private Dns A Record ID
+func ValidatePrivateDnsARecordID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParsePrivateDnsARecordID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Private Dns A Record ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
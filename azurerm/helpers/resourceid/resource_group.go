@@ -0,0 +1,60 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type ResourceGroupId struct {
+	SubscriptionId string
+	Name           string
+}
+
+func NewResourceGroupID(subscriptionId, name string) ResourceGroupId {
+	return ResourceGroupId{
+		SubscriptionId: subscriptionId,
+		Name:           name,
+	}
+}
+
+func (id ResourceGroupId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.Name)
+}
+
+// ParseResourceGroupID parses a Resource Group ID into a ResourceGroupId struct
+func ParseResourceGroupID(input string) (*ResourceGroupId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Resource Group ID: %+v", input, err)
+	}
+
+	resourceId := ResourceGroupId{
+		SubscriptionId: id.SubscriptionID,
+		Name:           id.ResourceGroup,
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateResourceGroupID validates that the specified value is a Resource Group ID
+func ValidateResourceGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParseResourceGroupID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Resource Group ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
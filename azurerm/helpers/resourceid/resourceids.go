@@ -0,0 +1,74 @@
+package resourceid
+
+// This package contains strongly typed Resource IDs, which can be parsed, formatted and validated.
+//
+// The Resource IDs are generated from an example ID using the generator in the `generator` directory - to add
+// a new Resource ID add a `go:generate` line below and then run `go generate ./azurerm/helpers/resourceid/...`
+
+//go:generate go run ./generator -name=ResourceGroup -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1
+//go:generate go run ./generator -name=AppServicePlan -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Web/serverfarms/plan1
+//go:generate go run ./generator -name=AppService -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Web/sites/site1
+//go:generate go run ./generator -name=ApplicationGateway -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/gateway1
+//go:generate go run ./generator -name=ApplicationSecurityGroup -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/applicationSecurityGroups/group1
+//go:generate go run ./generator -name=AvailabilitySet -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Compute/availabilitySets/set1
+//go:generate go run ./generator -name=ContainerRegistry -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.ContainerRegistry/registries/registry1
+//go:generate go run ./generator -name=CosmosDBAccount -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.DocumentDB/databaseAccounts/account1
+//go:generate go run ./generator -name=DdosProtectionPlan -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/ddosProtectionPlans/plan1
+//go:generate go run ./generator -name=DnsARecord -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/dnsZones/zone1/A/record1
+//go:generate go run ./generator -name=DnsAaaaRecord -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/dnsZones/zone1/AAAA/record1
+//go:generate go run ./generator -name=DnsCaaRecord -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/dnsZones/zone1/CAA/record1
+//go:generate go run ./generator -name=DnsCnameRecord -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/dnsZones/zone1/CNAME/record1
+//go:generate go run ./generator -name=DnsMxRecord -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/dnsZones/zone1/MX/record1
+//go:generate go run ./generator -name=DnsNsRecord -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/dnsZones/zone1/NS/record1
+//go:generate go run ./generator -name=DnsPtrRecord -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/dnsZones/zone1/PTR/record1
+//go:generate go run ./generator -name=DnsSrvRecord -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/dnsZones/zone1/SRV/record1
+//go:generate go run ./generator -name=DnsTxtRecord -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/dnsZones/zone1/TXT/record1
+//go:generate go run ./generator -name=DnsZone -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/dnszones/zone1
+//go:generate go run ./generator -name=EventHubNamespace -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.EventHub/namespaces/namespace1
+//go:generate go run ./generator -name=ExpressRouteCircuit -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/expressRouteCircuits/circuit1
+//go:generate go run ./generator -name=Firewall -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/azureFirewalls/firewall1
+//go:generate go run ./generator -name=Image -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Compute/images/image1
+//go:generate go run ./generator -name=KeyVault -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1
+//go:generate go run ./generator -name=KubernetesCluster -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.ContainerService/managedClusters/cluster1
+//go:generate go run ./generator -name=KubernetesClusterNodePool -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.ContainerService/managedClusters/cluster1/agentPools/pool1
+//go:generate go run ./generator -name=LoadBalancer -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/lb1
+//go:generate go run ./generator -name=LoadBalancerBackendAddressPool -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/lb1/backendAddressPools/pool1
+//go:generate go run ./generator -name=LocalNetworkGateway -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/localNetworkGateways/gateway1
+//go:generate go run ./generator -name=LogAnalyticsWorkspace -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.OperationalInsights/workspaces/workspace1
+//go:generate go run ./generator -name=ManagedDisk -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Compute/disks/disk1
+//go:generate go run ./generator -name=MySqlServer -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.DBforMySQL/servers/server1
+//go:generate go run ./generator -name=NatGateway -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/natGateways/gateway1
+//go:generate go run ./generator -name=NetworkInterface -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/networkInterfaces/nic1
+//go:generate go run ./generator -name=NetworkProfile -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/networkProfiles/profile1
+//go:generate go run ./generator -name=NetworkSecurityGroup -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/networkSecurityGroups/group1
+//go:generate go run ./generator -name=NetworkSecurityRule -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/networkSecurityGroups/group1/securityRules/rule1
+//go:generate go run ./generator -name=NetworkWatcher -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/networkWatchers/watcher1
+//go:generate go run ./generator -name=PostgreSQLServer -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.DBforPostgreSQL/servers/server1
+//go:generate go run ./generator -name=PrivateDnsARecord -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/privateDnsZones/zone1/A/record1
+//go:generate go run ./generator -name=PrivateDnsAaaaRecord -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/privateDnsZones/zone1/AAAA/record1
+//go:generate go run ./generator -name=PrivateDnsCnameRecord -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/privateDnsZones/zone1/CNAME/record1
+//go:generate go run ./generator -name=PrivateDnsPtrRecord -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/privateDnsZones/zone1/PTR/record1
+//go:generate go run ./generator -name=PrivateDnsSrvRecord -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/privateDnsZones/zone1/SRV/record1
+//go:generate go run ./generator -name=PrivateDnsTxtRecord -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/privateDnsZones/zone1/TXT/record1
+//go:generate go run ./generator -name=PrivateDnsZone -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/privateDnsZones/zone1
+//go:generate go run ./generator -name=PrivateDnsZoneVirtualNetworkLink -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/privateDnsZones/zone1/virtualNetworkLinks/link1
+//go:generate go run ./generator -name=PublicIPAddress -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1
+//go:generate go run ./generator -name=PublicIPPrefix -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/publicIPPrefixes/prefix1
+//go:generate go run ./generator -name=Route -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/routeTables/table1/routes/route1
+//go:generate go run ./generator -name=RouteTable -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/routeTables/table1
+//go:generate go run ./generator -name=ServiceBusNamespace -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.ServiceBus/namespaces/namespace1
+//go:generate go run ./generator -name=SharedImage -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Compute/galleries/gallery1/images/image1
+//go:generate go run ./generator -name=SharedImageGallery -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Compute/galleries/gallery1
+//go:generate go run ./generator -name=SharedImageVersion -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Compute/galleries/gallery1/images/image1/versions/1.0.0
+//go:generate go run ./generator -name=Snapshot -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Compute/snapshots/snapshot1
+//go:generate go run ./generator -name=SqlDatabase -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/database1
+//go:generate go run ./generator -name=SqlServer -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Sql/servers/server1
+//go:generate go run ./generator -name=StorageAccount -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1
+//go:generate go run ./generator -name=Subnet -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1
+//go:generate go run ./generator -name=UserAssignedIdentity -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1
+//go:generate go run ./generator -name=VirtualMachine -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/machine1
+//go:generate go run ./generator -name=VirtualMachineScaleSet -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Compute/virtualMachineScaleSets/set1
+//go:generate go run ./generator -name=VirtualNetwork -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1
+//go:generate go run ./generator -name=VirtualNetworkGateway -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworkGateways/gateway1
+//go:generate go run ./generator -name=VirtualNetworkPeering -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/virtualNetworkPeerings/peering1
+//go:generate go run ./generator -name=WebApplicationFirewallPolicy -id=/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/ApplicationGatewayWebApplicationFirewallPolicies/policy1
@@ -0,0 +1,77 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type RouteId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	RouteTableName string
+	Name           string
+}
+
+func NewRouteID(subscriptionId, resourceGroup, routeTableName, name string) RouteId {
+	return RouteId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		RouteTableName: routeTableName,
+		Name:           name,
+	}
+}
+
+func (id RouteId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/routeTables/%s/routes/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RouteTableName, id.Name)
+}
+
+// ParseRouteID parses a Route ID into a RouteId struct
+func ParseRouteID(input string) (*RouteId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Route ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Network") {
+		return nil, fmt.Errorf("parsing %q as a Route ID: expected the provider %q but got %q", input, "Microsoft.Network", id.Provider)
+	}
+
+	resourceId := RouteId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.RouteTableName, err = id.PopSegment("routeTables"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("routes"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateRouteID validates that the specified value is a Route ID
+func ValidateRouteID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParseRouteID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Route ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,77 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type SharedImageId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	GalleryName    string
+	Name           string
+}
+
+func NewSharedImageID(subscriptionId, resourceGroup, galleryName, name string) SharedImageId {
+	return SharedImageId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		GalleryName:    galleryName,
+		Name:           name,
+	}
+}
+
+func (id SharedImageId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/galleries/%s/images/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.GalleryName, id.Name)
+}
+
+// ParseSharedImageID parses a Shared Image ID into a SharedImageId struct
+func ParseSharedImageID(input string) (*SharedImageId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Shared Image ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("parsing %q as a Shared Image ID: expected the provider %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := SharedImageId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.GalleryName, err = id.PopSegment("galleries"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("images"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSharedImageID validates that the specified value is a Shared Image ID
+func ValidateSharedImageID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParseSharedImageID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Shared Image ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
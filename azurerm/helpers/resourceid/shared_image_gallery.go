@@ -0,0 +1,71 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type SharedImageGalleryId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewSharedImageGalleryID(subscriptionId, resourceGroup, name string) SharedImageGalleryId {
+	return SharedImageGalleryId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id SharedImageGalleryId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/galleries/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseSharedImageGalleryID parses a Shared Image Gallery ID into a SharedImageGalleryId struct
+func ParseSharedImageGalleryID(input string) (*SharedImageGalleryId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Shared Image Gallery ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("parsing %q as a Shared Image Gallery ID: expected the provider %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := SharedImageGalleryId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("galleries"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSharedImageGalleryID validates that the specified value is a Shared Image Gallery ID
+func ValidateSharedImageGalleryID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParseSharedImageGalleryID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Shared Image Gallery ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
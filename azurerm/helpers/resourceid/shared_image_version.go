@@ -0,0 +1,83 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type SharedImageVersionId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	GalleryName    string
+	ImageName      string
+	Name           string
+}
+
+func NewSharedImageVersionID(subscriptionId, resourceGroup, galleryName, imageName, name string) SharedImageVersionId {
+	return SharedImageVersionId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		GalleryName:    galleryName,
+		ImageName:      imageName,
+		Name:           name,
+	}
+}
+
+func (id SharedImageVersionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/galleries/%s/images/%s/versions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.GalleryName, id.ImageName, id.Name)
+}
+
+// ParseSharedImageVersionID parses a Shared Image Version ID into a SharedImageVersionId struct
+func ParseSharedImageVersionID(input string) (*SharedImageVersionId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Shared Image Version ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Compute") {
+		return nil, fmt.Errorf("parsing %q as a Shared Image Version ID: expected the provider %q but got %q", input, "Microsoft.Compute", id.Provider)
+	}
+
+	resourceId := SharedImageVersionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.GalleryName, err = id.PopSegment("galleries"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.ImageName, err = id.PopSegment("images"); err != nil {
+		return nil, err
+	}
+
+	if resourceId.Name, err = id.PopSegment("versions"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateSharedImageVersionID validates that the specified value is a Shared Image Version ID
+func ValidateSharedImageVersionID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParseSharedImageVersionID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Shared Image Version ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
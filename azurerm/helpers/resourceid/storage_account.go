@@ -0,0 +1,71 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type StorageAccountId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewStorageAccountID(subscriptionId, resourceGroup, name string) StorageAccountId {
+	return StorageAccountId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id StorageAccountId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseStorageAccountID parses a Storage Account ID into a StorageAccountId struct
+func ParseStorageAccountID(input string) (*StorageAccountId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a Storage Account ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.Storage") {
+		return nil, fmt.Errorf("parsing %q as a Storage Account ID: expected the provider %q but got %q", input, "Microsoft.Storage", id.Provider)
+	}
+
+	resourceId := StorageAccountId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("storageAccounts"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateStorageAccountID validates that the specified value is a Storage Account ID
+func ValidateStorageAccountID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParseStorageAccountID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Storage Account ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
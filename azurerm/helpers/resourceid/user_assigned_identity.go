@@ -0,0 +1,71 @@
+package resourceid
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type UserAssignedIdentityId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewUserAssignedIdentityID(subscriptionId, resourceGroup, name string) UserAssignedIdentityId {
+	return UserAssignedIdentityId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id UserAssignedIdentityId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ManagedIdentity/userAssignedIdentities/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseUserAssignedIdentityID parses a User Assigned Identity ID into a UserAssignedIdentityId struct
+func ParseUserAssignedIdentityID(input string) (*UserAssignedIdentityId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a User Assigned Identity ID: %+v", input, err)
+	}
+
+	if !strings.EqualFold(id.Provider, "Microsoft.ManagedIdentity") {
+		return nil, fmt.Errorf("parsing %q as a User Assigned Identity ID: expected the provider %q but got %q", input, "Microsoft.ManagedIdentity", id.Provider)
+	}
+
+	resourceId := UserAssignedIdentityId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.Name, err = id.PopSegment("userAssignedIdentities"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ValidateUserAssignedIdentityID validates that the specified value is a User Assigned Identity ID
+func ValidateUserAssignedIdentityID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if _, err := ParseUserAssignedIdentityID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a User Assigned Identity ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
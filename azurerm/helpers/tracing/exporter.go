@@ -0,0 +1,236 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Exporter exports Spans which have ended
+type Exporter interface {
+	Export(spans []SpanData) error
+}
+
+// ServiceName is the name of the service which the exported Spans are attributed to
+const ServiceName = "terraform-provider-azurerm"
+
+type multiExporter []Exporter
+
+// NewMultiExporter returns an Exporter which exports Spans using each of the specified Exporters
+func NewMultiExporter(exporters ...Exporter) Exporter {
+	if len(exporters) == 1 {
+		return exporters[0]
+	}
+
+	return multiExporter(exporters)
+}
+
+func (m multiExporter) Export(spans []SpanData) error {
+	errors := make([]string, 0)
+	for _, exporter := range m {
+		if err := exporter.Export(spans); err != nil {
+			errors = append(errors, err.Error())
+		}
+	}
+
+	if len(errors) > 0 {
+		return fmt.Errorf("%s", strings.Join(errors, "; "))
+	}
+
+	return nil
+}
+
+// fileExporter appends each Span to a file as a line of JSON, which can be analysed using tools like `jq`
+type fileExporter struct {
+	path string
+	lock sync.Mutex
+}
+
+type fileSpan struct {
+	TraceID      string                 `json:"trace_id"`
+	SpanID       string                 `json:"span_id"`
+	ParentSpanID string                 `json:"parent_span_id,omitempty"`
+	Name         string                 `json:"name"`
+	StartTime    time.Time              `json:"start_time"`
+	EndTime      time.Time              `json:"end_time"`
+	DurationMs   int64                  `json:"duration_ms"`
+	Attributes   map[string]interface{} `json:"attributes,omitempty"`
+	Error        string                 `json:"error,omitempty"`
+}
+
+// NewFileExporter returns an Exporter which appends each Span to the file at the specified path as a line of JSON
+func NewFileExporter(path string) (Exporter, error) {
+	// ensure the file can be written to before any Spans are exported
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("Error opening trace file %q: %+v", path, err)
+	}
+	file.Close()
+
+	return &fileExporter{
+		path: path,
+	}, nil
+}
+
+func (e *fileExporter) Export(spans []SpanData) error {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, span := range spans {
+		line := fileSpan{
+			TraceID:      span.TraceID,
+			SpanID:       span.SpanID,
+			ParentSpanID: span.ParentSpanID,
+			Name:         span.Name,
+			StartTime:    span.StartTime.UTC(),
+			EndTime:      span.EndTime.UTC(),
+			DurationMs:   int64(span.Duration() / time.Millisecond),
+			Attributes:   span.Attributes,
+			Error:        span.Error,
+		}
+		if err := encoder.Encode(line); err != nil {
+			return fmt.Errorf("Error encoding Span %q: %+v", span.Name, err)
+		}
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	file, err := os.OpenFile(e.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("Error opening trace file %q: %+v", e.path, err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("Error writing to trace file %q: %+v", e.path, err)
+	}
+
+	return nil
+}
+
+// otlpExporter sends Spans to an OpenTelemetry Collector using OTLP/HTTP with the JSON encoding
+type otlpExporter struct {
+	url    string
+	client *http.Client
+}
+
+// NewOTLPExporter returns an Exporter which sends Spans to the OTLP/HTTP endpoint of an OpenTelemetry Collector,
+// for example `http://localhost:4318` - where `/v1/traces` is appended when it's not already present
+func NewOTLPExporter(endpoint string) Exporter {
+	url := strings.TrimSuffix(endpoint, "/")
+	if !strings.HasSuffix(url, "/v1/traces") {
+		url = url + "/v1/traces"
+	}
+
+	return &otlpExporter{
+		url: url,
+		client: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+func (e *otlpExporter) Export(spans []SpanData) error {
+	body, err := json.Marshal(otlpRequest(spans))
+	if err != nil {
+		return fmt.Errorf("Error encoding Spans: %+v", err)
+	}
+
+	resp, err := e.client.Post(e.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("Error sending Spans to %q: %+v", e.url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		message, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("Error sending Spans to %q: unexpected status %d: %s", e.url, resp.StatusCode, string(message))
+	}
+
+	return nil
+}
+
+func otlpRequest(spans []SpanData) map[string]interface{} {
+	otlpSpans := make([]interface{}, 0, len(spans))
+	for _, span := range spans {
+		otlpSpan := map[string]interface{}{
+			"traceId":           span.TraceID,
+			"spanId":            span.SpanID,
+			"name":              span.Name,
+			"kind":              int(span.Kind),
+			"startTimeUnixNano": strconv.FormatInt(span.StartTime.UnixNano(), 10),
+			"endTimeUnixNano":   strconv.FormatInt(span.EndTime.UnixNano(), 10),
+			"attributes":        otlpAttributes(span.Attributes),
+		}
+		if span.ParentSpanID != "" {
+			otlpSpan["parentSpanId"] = span.ParentSpanID
+		}
+		if span.Error != "" {
+			otlpSpan["status"] = map[string]interface{}{
+				"code":    2,
+				"message": span.Error,
+			}
+		}
+		otlpSpans = append(otlpSpans, otlpSpan)
+	}
+
+	return map[string]interface{}{
+		"resourceSpans": []interface{}{
+			map[string]interface{}{
+				"resource": map[string]interface{}{
+					"attributes": otlpAttributes(map[string]interface{}{
+						"service.name": ServiceName,
+					}),
+				},
+				"scopeSpans": []interface{}{
+					map[string]interface{}{
+						"scope": map[string]interface{}{
+							"name": ServiceName,
+						},
+						"spans": otlpSpans,
+					},
+				},
+			},
+		},
+	}
+}
+
+func otlpAttributes(input map[string]interface{}) []interface{} {
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	attributes := make([]interface{}, 0, len(input))
+	for _, k := range keys {
+		var value map[string]interface{}
+		switch v := input[k].(type) {
+		case bool:
+			value = map[string]interface{}{"boolValue": v}
+		case int:
+			value = map[string]interface{}{"intValue": strconv.Itoa(v)}
+		case int64:
+			value = map[string]interface{}{"intValue": strconv.FormatInt(v, 10)}
+		case float64:
+			value = map[string]interface{}{"doubleValue": v}
+		default:
+			value = map[string]interface{}{"stringValue": fmt.Sprintf("%v", v)}
+		}
+
+		attributes = append(attributes, map[string]interface{}{
+			"key":   k,
+			"value": value,
+		})
+	}
+
+	return attributes
+}
@@ -0,0 +1,150 @@
+package tracing
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func testSpans() []SpanData {
+	start := time.Date(2019, 5, 20, 10, 0, 0, 0, time.UTC)
+	return []SpanData{
+		{
+			TraceID:      "0af7651916cd43dd8448eb211c80319c",
+			SpanID:       "b7ad6b7169203331",
+			ParentSpanID: "00f067aa0ba902b7",
+			Name:         "PUT /subscriptions/123/resourceGroups/example",
+			Kind:         SpanKindClient,
+			StartTime:    start,
+			EndTime:      start.Add(1500 * time.Millisecond),
+			Attributes: map[string]interface{}{
+				"http.status_code":  201,
+				"azure.lro.polling": false,
+			},
+		},
+		{
+			TraceID:   "0af7651916cd43dd8448eb211c80319c",
+			SpanID:    "00f067aa0ba902b7",
+			Name:      "azurerm_resource_group.create",
+			Kind:      SpanKindInternal,
+			StartTime: start,
+			EndTime:   start.Add(2 * time.Second),
+			Attributes: map[string]interface{}{
+				"azure.request_count": int64(2),
+			},
+			Error: "bad things",
+		},
+	}
+}
+
+func TestFileExporter(t *testing.T) {
+	directory, err := ioutil.TempDir("", "tracing")
+	if err != nil {
+		t.Fatalf("Error creating temporary directory: %+v", err)
+	}
+	defer os.RemoveAll(directory)
+	path := filepath.Join(directory, "trace.json")
+
+	exporter, err := NewFileExporter(path)
+	if err != nil {
+		t.Fatalf("Error creating File Exporter: %+v", err)
+	}
+	if err := exporter.Export(testSpans()); err != nil {
+		t.Fatalf("Error exporting Spans: %+v", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Error opening trace file: %+v", err)
+	}
+	defer file.Close()
+
+	lines := make([]map[string]interface{}, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var line map[string]interface{}
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatalf("Error parsing line %q: %+v", scanner.Text(), err)
+		}
+		lines = append(lines, line)
+	}
+
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines but got %d", len(lines))
+	}
+	if v := lines[0]["duration_ms"]; v != float64(1500) {
+		t.Fatalf("Expected the duration to be 1500ms but got %v", v)
+	}
+	if v := lines[1]["error"]; v != "bad things" {
+		t.Fatalf("Expected the error to be `bad things` but got %v", v)
+	}
+	if v := lines[1]["name"]; v != "azurerm_resource_group.create" {
+		t.Fatalf("Expected the name to be `azurerm_resource_group.create` but got %v", v)
+	}
+}
+
+func TestOTLPExporter(t *testing.T) {
+	var received map[string]interface{}
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	exporter := NewOTLPExporter(server.URL + "/")
+	if err := exporter.Export(testSpans()); err != nil {
+		t.Fatalf("Error exporting Spans: %+v", err)
+	}
+
+	if path != "/v1/traces" {
+		t.Fatalf("Expected the Spans to be sent to `/v1/traces` but got %q", path)
+	}
+
+	resourceSpans := received["resourceSpans"].([]interface{})
+	scopeSpans := resourceSpans[0].(map[string]interface{})["scopeSpans"].([]interface{})
+	spans := scopeSpans[0].(map[string]interface{})["spans"].([]interface{})
+	if len(spans) != 2 {
+		t.Fatalf("Expected 2 Spans but got %d", len(spans))
+	}
+
+	first := spans[0].(map[string]interface{})
+	if v := first["parentSpanId"]; v != "00f067aa0ba902b7" {
+		t.Fatalf("Expected the Parent Span ID to be `00f067aa0ba902b7` but got %v", v)
+	}
+	if v := first["endTimeUnixNano"]; v != "1558346401500000000" {
+		t.Fatalf("Expected the End Time to be `1558346401500000000` but got %v", v)
+	}
+
+	attributes := first["attributes"].([]interface{})
+	statusCode := attributes[1].(map[string]interface{})
+	if statusCode["key"] != "http.status_code" || statusCode["value"].(map[string]interface{})["intValue"] != "201" {
+		t.Fatalf("Expected the status code to be exported as an integer but got %+v", statusCode)
+	}
+
+	second := spans[1].(map[string]interface{})
+	if status := second["status"].(map[string]interface{}); status["code"] != float64(2) || status["message"] != "bad things" {
+		t.Fatalf("Expected the error to be exported as the status but got %+v", status)
+	}
+}
+
+func TestOTLPExporterError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	if err := NewOTLPExporter(server.URL).Export(testSpans()); err == nil {
+		t.Fatalf("Expected an error when the Collector returns an error")
+	}
+}
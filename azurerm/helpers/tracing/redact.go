@@ -0,0 +1,215 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Redacted is the value which sensitive values are replaced with
+const Redacted = "REDACTED"
+
+// sensitiveFieldSuffixes are the (lower-cased) suffixes of the names of JSON fields which contain secrets,
+// for example `primaryKey`, `primaryConnectionString` and `administratorLoginPassword`
+var sensitiveFieldSuffixes = []string{
+	"connectionstring",
+	"connectionstrings",
+	"credential",
+	"credentials",
+	"key",
+	"keys",
+	"password",
+	"protectedsettings",
+	"sastoken",
+	"secret",
+	"token",
+}
+
+// nonSensitiveFields are the (lower-cased) names of JSON fields matching one of the suffixes above which
+// don't contain secrets, and are useful for debugging
+var nonSensitiveFields = map[string]struct{}{
+	"partitionkey":  {},
+	"publickey":     {},
+	"publickeys":    {},
+	"rowkey":        {},
+	"sshpublickey":  {},
+	"sshpublickeys": {},
+}
+
+// sensitiveQueryParameters are the (lower-cased) names of Query String parameters which contain secrets,
+// for example the signature of a SAS Token
+var sensitiveQueryParameters = map[string]struct{}{
+	"access_token":  {},
+	"client_secret": {},
+	"code":          {},
+	"sig":           {},
+}
+
+// sensitiveHeaders are the (canonical) names of HTTP Headers which contain secrets
+var sensitiveHeaders = map[string]struct{}{
+	"Authorization":                {},
+	"X-Ms-Authorization-Auxiliary": {},
+	"Ocp-Apim-Subscription-Key":    {},
+}
+
+// settingsListPathRegex matches the API used to list the App Settings and Connection Strings of an App Service,
+// where every value within `properties` is potentially sensitive
+var settingsListPathRegex = regexp.MustCompile(`(?i)/config/(appsettings|connectionstrings)(/list)?$`)
+
+// secretPathRegex matches the Key Vault API for Secrets, where the `value` field contains the Secret
+var secretPathRegex = regexp.MustCompile(`(?i)/secrets(/|$)`)
+
+// RedactJSON returns a copy of the JSON body of a request to (or response from) the specified path, where
+// the values of any fields which contain secrets (e.g. keys, passwords and connection strings) are redacted.
+// When the body isn't valid JSON a placeholder is returned, since it's not possible to tell what it contains.
+func RedactJSON(path string, body []byte) []byte {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return body
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var input interface{}
+	if err := decoder.Decode(&input); err != nil {
+		return []byte("[unparseable JSON body]")
+	}
+
+	output := redactValue(input)
+
+	if settingsListPathRegex.MatchString(path) {
+		if v, ok := output.(map[string]interface{}); ok {
+			if properties, ok := v["properties"].(map[string]interface{}); ok {
+				for k := range properties {
+					properties[k] = Redacted
+				}
+			}
+		}
+	}
+
+	if secretPathRegex.MatchString(path) {
+		redactSecretValues(output)
+	}
+
+	redacted, err := json.Marshal(output)
+	if err != nil {
+		return []byte("[unparseable JSON body]")
+	}
+
+	return redacted
+}
+
+func redactValue(input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isSensitiveField(key) && value != nil {
+				v[key] = Redacted
+				continue
+			}
+
+			v[key] = redactValue(value)
+		}
+		return v
+
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactValue(value)
+		}
+		return v
+	}
+
+	return input
+}
+
+func redactSecretValues(input interface{}) {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		if _, ok := v["value"]; ok {
+			v["value"] = Redacted
+		}
+		for _, value := range v {
+			redactSecretValues(value)
+		}
+
+	case []interface{}:
+		for _, value := range v {
+			redactSecretValues(value)
+		}
+	}
+}
+
+func isSensitiveField(name string) bool {
+	name = strings.ToLower(name)
+	if _, ok := nonSensitiveFields[name]; ok {
+		return false
+	}
+
+	for _, suffix := range sensitiveFieldSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RedactURL returns the specified URL as a string, with the values of any sensitive Query String parameters redacted
+func RedactURL(input *url.URL) string {
+	if input == nil {
+		return ""
+	}
+
+	output := *input
+	query := output.Query()
+	changed := false
+	for key := range query {
+		if _, ok := sensitiveQueryParameters[strings.ToLower(key)]; ok {
+			query.Set(key, Redacted)
+			changed = true
+		}
+	}
+	if changed {
+		output.RawQuery = query.Encode()
+	}
+
+	return output.String()
+}
+
+// RedactError returns a copy of the specified error with any sensitive Query String Parameters redacted
+// from the URL when it's a *url.Error (as returned by a http.Client) - other errors are returned as-is
+func RedactError(err error) error {
+	urlErr, ok := err.(*url.Error)
+	if !ok {
+		return err
+	}
+
+	redacted := Redacted
+	if u, parseErr := url.Parse(urlErr.URL); parseErr == nil {
+		redacted = RedactURL(u)
+	}
+
+	return &url.Error{
+		Op:  urlErr.Op,
+		URL: redacted,
+		Err: urlErr.Err,
+	}
+}
+
+// RedactHeaders returns a copy of the specified HTTP Headers with the values of any sensitive Headers redacted
+func RedactHeaders(input http.Header) http.Header {
+	output := make(http.Header, len(input))
+	for key, values := range input {
+		if _, ok := sensitiveHeaders[http.CanonicalHeaderKey(key)]; ok {
+			output[key] = []string{Redacted}
+			continue
+		}
+
+		output[key] = append([]string{}, values...)
+	}
+
+	return output
+}
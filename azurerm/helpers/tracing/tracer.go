@@ -0,0 +1,247 @@
+// Package tracing records a Span for each Terraform operation performed on a Resource (e.g. a Create) and for each
+// request made to Azure during it - which allows the resources which make an apply slow to be identified.
+//
+// Spans are modelled on OpenTelemetry Spans and can be exported to a local file or an OTLP Collector.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SpanKind describes the relationship between a Span and the work it represents
+type SpanKind int
+
+const (
+	// SpanKindInternal is used for Spans representing a Terraform operation on a Resource
+	SpanKindInternal SpanKind = 1
+
+	// SpanKindClient is used for Spans representing a request made to Azure
+	SpanKindClient SpanKind = 3
+)
+
+// SpanData is a snapshot of a Span which has ended, which is passed to an Exporter
+type SpanData struct {
+	TraceID      string
+	SpanID       string
+	ParentSpanID string
+	Name         string
+	Kind         SpanKind
+	StartTime    time.Time
+	EndTime      time.Time
+	Attributes   map[string]interface{}
+	Error        string
+}
+
+// Duration returns how long the Span took
+func (s SpanData) Duration() time.Duration {
+	return s.EndTime.Sub(s.StartTime)
+}
+
+// Tracer creates Spans and exports them once the Trace they're a part of has completed
+type Tracer struct {
+	exporter Exporter
+
+	lock    sync.Mutex
+	pending map[string][]SpanData
+}
+
+// NewTracer returns a Tracer which exports Spans using the specified Exporter
+func NewTracer(exporter Exporter) *Tracer {
+	return &Tracer{
+		exporter: exporter,
+		pending:  make(map[string][]SpanData),
+	}
+}
+
+// Start starts a new Span - which is a child of the Span within the specified context, if there is one - and
+// returns a context containing the new Span, which must be ended by calling End
+func (t *Tracer) Start(ctx context.Context, name string, kind SpanKind) (context.Context, *Span) {
+	span := &Span{
+		tracer:     t,
+		spanID:     newID(8),
+		name:       name,
+		kind:       kind,
+		startTime:  time.Now(),
+		attributes: make(map[string]interface{}),
+	}
+
+	if parent := SpanFromContext(ctx); parent != nil {
+		span.parent = parent
+		span.traceID = parent.traceID
+	} else {
+		span.traceID = newID(16)
+	}
+
+	return context.WithValue(ctx, spanContextKey{}, span), span
+}
+
+func (t *Tracer) finish(span SpanData, root bool) {
+	t.lock.Lock()
+	spans := append(t.pending[span.TraceID], span)
+	if !root {
+		t.pending[span.TraceID] = spans
+		t.lock.Unlock()
+		return
+	}
+	delete(t.pending, span.TraceID)
+	t.lock.Unlock()
+
+	// Spans are exported when the Trace completes, rather than in the background, since the Provider
+	// process can be stopped at any point once Terraform has finished with it
+	if err := t.exporter.Export(spans); err != nil {
+		log.Printf("[WARN] Error exporting %d Spans: %+v", len(spans), err)
+	}
+}
+
+type spanContextKey struct{}
+
+// SpanFromContext returns the Span within the specified context, if there is one
+func SpanFromContext(ctx context.Context) *Span {
+	if ctx == nil {
+		return nil
+	}
+
+	span, _ := ctx.Value(spanContextKey{}).(*Span)
+	return span
+}
+
+// Span records a single unit of work - either a Terraform operation on a Resource or a request made to Azure
+type Span struct {
+	tracer  *Tracer
+	parent  *Span
+	traceID string
+	spanID  string
+	name    string
+	kind    SpanKind
+
+	lock       sync.Mutex
+	startTime  time.Time
+	attributes map[string]interface{}
+	ended      bool
+
+	// pollingURLs are the URLs of the Long Running Operations started within this Span, which are polled until they complete
+	pollingURLs map[string]struct{}
+
+	// lastPolled is when a Long Running Operation within this Span was last polled (or started)
+	lastPolled time.Time
+}
+
+// Parent returns the parent of this Span, if it has one
+func (s *Span) Parent() *Span {
+	return s.parent
+}
+
+// SetAttribute sets the value of the specified attribute on this Span
+func (s *Span) SetAttribute(key string, value interface{}) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.attributes[key] = value
+}
+
+// Attribute returns the value of the specified attribute on this Span, if it's set
+func (s *Span) Attribute(key string) interface{} {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.attributes[key]
+}
+
+// IncrementAttribute adds the delta to the value of the specified (numeric) attribute on this Span
+func (s *Span) IncrementAttribute(key string, delta int64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	existing, _ := s.attributes[key].(int64)
+	s.attributes[key] = existing + delta
+}
+
+// StartPolling records that a Long Running Operation has been started within this Span, which will be polled
+// using one of the specified URLs (taken from the `Azure-AsyncOperation` and `Location` headers)
+func (s *Span) StartPolling(urls ...string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.pollingURLs == nil {
+		s.pollingURLs = make(map[string]struct{})
+	}
+	for _, url := range urls {
+		if url != "" {
+			s.pollingURLs[strings.ToLower(url)] = struct{}{}
+		}
+	}
+	s.lastPolled = time.Now()
+}
+
+// IsPolling returns whether the specified URL is used to poll a Long Running Operation started within this Span
+func (s *Span) IsPolling(url string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	_, ok := s.pollingURLs[strings.ToLower(url)]
+	return ok
+}
+
+// RecordPoll records that a Long Running Operation within this Span has been polled, adding the time spent
+// waiting for it since it was started (or last polled) to the `azure.lro.polling_time_ms` attribute
+func (s *Span) RecordPoll() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := time.Now()
+	if !s.lastPolled.IsZero() {
+		existing, _ := s.attributes["azure.lro.polling_time_ms"].(int64)
+		s.attributes["azure.lro.polling_time_ms"] = existing + int64(now.Sub(s.lastPolled)/time.Millisecond)
+	}
+	s.lastPolled = now
+}
+
+// End ends this Span, recording the error (if any) which occurred - once the root Span of a Trace has ended
+// all of the Spans within the Trace are exported
+func (s *Span) End(err error) {
+	s.lock.Lock()
+	if s.ended {
+		s.lock.Unlock()
+		return
+	}
+	s.ended = true
+
+	data := SpanData{
+		TraceID:    s.traceID,
+		SpanID:     s.spanID,
+		Name:       s.name,
+		Kind:       s.kind,
+		StartTime:  s.startTime,
+		EndTime:    time.Now(),
+		Attributes: make(map[string]interface{}, len(s.attributes)),
+	}
+	for k, v := range s.attributes {
+		data.Attributes[k] = v
+	}
+	s.lock.Unlock()
+
+	if s.parent != nil {
+		data.ParentSpanID = s.parent.spanID
+	}
+	if err != nil {
+		data.Error = RedactError(err).Error()
+	}
+
+	s.tracer.finish(data, s.parent == nil)
+}
+
+func newID(length int) string {
+	id := make([]byte, length)
+	if _, err := rand.Read(id); err != nil {
+		// this should never happen, but the ID only needs to be unique within the exported Traces
+		return strings.Repeat("0", length*2)
+	}
+
+	return hex.EncodeToString(id)
+}
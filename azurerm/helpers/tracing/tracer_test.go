@@ -0,0 +1,101 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+type testExporter struct {
+	exports [][]SpanData
+}
+
+func (e *testExporter) Export(spans []SpanData) error {
+	e.exports = append(e.exports, spans)
+	return nil
+}
+
+func TestTracerExportsWhenTheRootSpanEnds(t *testing.T) {
+	exporter := &testExporter{}
+	tracer := NewTracer(exporter)
+
+	ctx, root := tracer.Start(context.Background(), "azurerm_resource_group.create", SpanKindInternal)
+	_, child := tracer.Start(ctx, "PUT /subscriptions/123", SpanKindClient)
+	child.SetAttribute("http.status_code", 201)
+	child.End(nil)
+
+	if len(exporter.exports) != 0 {
+		t.Fatalf("Expected no Spans to be exported until the root Span ends")
+	}
+
+	root.IncrementAttribute("azure.request_count", 1)
+	root.IncrementAttribute("azure.request_count", 2)
+	root.End(fmt.Errorf("bad things"))
+
+	// ending a Span twice is a no-op
+	root.End(nil)
+
+	if len(exporter.exports) != 1 {
+		t.Fatalf("Expected 1 export but got %d", len(exporter.exports))
+	}
+	spans := exporter.exports[0]
+	if len(spans) != 2 {
+		t.Fatalf("Expected 2 Spans but got %d", len(spans))
+	}
+
+	childData, rootData := spans[0], spans[1]
+	if childData.TraceID != rootData.TraceID {
+		t.Fatalf("Expected the child Span to be a part of the same Trace as the root Span")
+	}
+	if childData.ParentSpanID != rootData.SpanID {
+		t.Fatalf("Expected the Parent Span ID to be %q but got %q", rootData.SpanID, childData.ParentSpanID)
+	}
+	if rootData.ParentSpanID != "" {
+		t.Fatalf("Expected the root Span to have no parent but got %q", rootData.ParentSpanID)
+	}
+	if v := childData.Attributes["http.status_code"]; v != 201 {
+		t.Fatalf("Expected the status code to be 201 but got %v", v)
+	}
+	if v := rootData.Attributes["azure.request_count"]; v != int64(3) {
+		t.Fatalf("Expected the request count to be 3 but got %v", v)
+	}
+	if rootData.Error != "bad things" {
+		t.Fatalf("Expected the error to be recorded but got %q", rootData.Error)
+	}
+	if len(rootData.TraceID) != 32 || len(rootData.SpanID) != 16 {
+		t.Fatalf("Expected a 16 byte Trace ID and an 8 byte Span ID but got %q and %q", rootData.TraceID, rootData.SpanID)
+	}
+}
+
+func TestSpanPolling(t *testing.T) {
+	tracer := NewTracer(&testExporter{})
+	_, span := tracer.Start(context.Background(), "azurerm_resource_group.delete", SpanKindInternal)
+
+	url := "https://management.azure.com/subscriptions/123/operations/abc?api-version=2018-05-01"
+	if span.IsPolling(url) {
+		t.Fatalf("Expected the URL not to be polled before the operation has started")
+	}
+
+	span.StartPolling("", url)
+	if !span.IsPolling(url) {
+		t.Fatalf("Expected the URL to be polled once the operation has started")
+	}
+
+	span.RecordPoll()
+	span.RecordPoll()
+	if _, ok := span.Attribute("azure.lro.polling_time_ms").(int64); !ok {
+		t.Fatalf("Expected the polling time to be recorded")
+	}
+}
+
+func TestSpanFromContext(t *testing.T) {
+	if SpanFromContext(context.Background()) != nil {
+		t.Fatalf("Expected no Span within an empty context")
+	}
+
+	tracer := NewTracer(&testExporter{})
+	ctx, span := tracer.Start(context.Background(), "example", SpanKindInternal)
+	if SpanFromContext(ctx) != span {
+		t.Fatalf("Expected the Span to be returned from the context")
+	}
+}
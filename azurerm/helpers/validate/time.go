@@ -48,6 +48,27 @@ func RFC3339DateInFutureBy(d time.Duration) schema.SchemaValidateFunc {
 	}
 }
 
+// Duration validates that the value is a positive duration, such as `30m` or `1h30m`
+func Duration(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q has the invalid duration %q: %+v", k, v, err))
+		return
+	}
+
+	if d <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be a positive duration but got %q", k, v))
+	}
+
+	return warnings, errors
+}
+
 func DayOfTheWeek(ignoreCase bool) schema.SchemaValidateFunc {
 	return validation.StringInSlice([]string{
 		"Monday",
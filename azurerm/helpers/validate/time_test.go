@@ -102,3 +102,45 @@ func TestRfc3339DateInFutureBy(t *testing.T) {
 		})
 	}
 }
+
+func TestDuration(t *testing.T) {
+	cases := []struct {
+		Duration string
+		Errors   int
+	}{
+		{
+			Duration: "",
+			Errors:   1,
+		},
+		{
+			Duration: "30",
+			Errors:   1,
+		},
+		{
+			Duration: "0s",
+			Errors:   1,
+		},
+		{
+			Duration: "-5m",
+			Errors:   1,
+		},
+		{
+			Duration: "30m",
+			Errors:   0,
+		},
+		{
+			Duration: "1h30m",
+			Errors:   0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Duration, func(t *testing.T) {
+			_, errors := Duration(tc.Duration, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected Duration to have %d not %d errors for %q", tc.Errors, len(errors), tc.Duration)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// keyVaultSoftDeletedItem is an item within a Key Vault (a Certificate, Key or Secret) which may be soft-deleted,
+// when Soft Delete is enabled on the Key Vault
+type keyVaultSoftDeletedItem struct {
+	// itemType is the type of item, e.g. `Secret`
+	itemType        string
+	name            string
+	keyVaultBaseUrl string
+
+	get        func(ctx context.Context) (autorest.Response, error)
+	getDeleted func(ctx context.Context) (autorest.Response, error)
+	recover    func(ctx context.Context) error
+	purge      func(ctx context.Context) (autorest.Response, error)
+}
+
+// recoverIfSoftDeleted recovers a soft-deleted item with the same name, so that it can be updated during the Create,
+// when the `recover_soft_deleted_key_vaults` feature is enabled - otherwise an error is returned, since the Create
+// would otherwise fail with a conflict
+func (i keyVaultSoftDeletedItem) recoverIfSoftDeleted(ctx context.Context, features keyVaultFeatures, timeout time.Duration) error {
+	deleted, err := i.getDeleted(ctx)
+	if err != nil {
+		// when Soft Delete isn't enabled on the Key Vault this returns an error, rather than a 404
+		if !utils.ResponseWasNotFound(deleted) {
+			log.Printf("[DEBUG] Unable to check for a soft-deleted %s %q (Key Vault %q): %+v", i.itemType, i.name, i.keyVaultBaseUrl, err)
+		}
+		return nil
+	}
+
+	if !features.recoverSoftDeletedKeyVaults {
+		return fmt.Errorf("A soft-deleted %s %q exists in Key Vault %q - either recover it by enabling `recover_soft_deleted_key_vaults` within the `key_vault` block of the `features` block in the Provider, or purge it and try again", i.itemType, i.name, i.keyVaultBaseUrl)
+	}
+
+	log.Printf("[DEBUG] Recovering soft-deleted %s %q (Key Vault %q)", i.itemType, i.name, i.keyVaultBaseUrl)
+	if err := i.recover(ctx); err != nil {
+		return fmt.Errorf("Error recovering soft-deleted %s %q (Key Vault %q): %+v", i.itemType, i.name, i.keyVaultBaseUrl, err)
+	}
+
+	// recovery is asynchronous, so we need to wait for the item to become available before it can be updated
+	log.Printf("[DEBUG] Waiting for %s %q (Key Vault %q) to be recovered", i.itemType, i.name, i.keyVaultBaseUrl)
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{"NotFound"},
+		Target:                    []string{"Exists"},
+		Refresh:                   keyVaultSoftDeletedItemRefreshFunc(ctx, i.get),
+		Timeout:                   timeout,
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for %s %q (Key Vault %q) to be recovered: %+v", i.itemType, i.name, i.keyVaultBaseUrl, err)
+	}
+
+	return nil
+}
+
+// purgeIfSoftDeleted purges the item once it's been deleted, when the `purge_soft_delete_on_destroy` feature is enabled
+// and the item was soft-deleted (which is the case when a Recovery ID is returned from the Delete) - unless Purge
+// Protection is enabled on the Key Vault, in which case only Azure can purge it
+func (i keyVaultSoftDeletedItem) purgeIfSoftDeleted(ctx context.Context, features keyVaultFeatures, recoveryId *string, recoveryLevel string, timeout time.Duration) error {
+	if !features.purgeSoftDeleteOnDestroy || recoveryId == nil {
+		return nil
+	}
+
+	if !strings.Contains(recoveryLevel, "Purgeable") {
+		log.Printf("[DEBUG] Skipping purging %s %q (Key Vault %q) since Purge Protection is enabled (Recovery Level %q)", i.itemType, i.name, i.keyVaultBaseUrl, recoveryLevel)
+		return nil
+	}
+
+	// the Delete is asynchronous, so the item can't be purged until it shows up as soft-deleted
+	log.Printf("[DEBUG] Waiting for %s %q (Key Vault %q) to be soft-deleted", i.itemType, i.name, i.keyVaultBaseUrl)
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{"NotFound"},
+		Target:                    []string{"Exists"},
+		Refresh:                   keyVaultSoftDeletedItemRefreshFunc(ctx, i.getDeleted),
+		Timeout:                   timeout,
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for %s %q (Key Vault %q) to be soft-deleted: %+v", i.itemType, i.name, i.keyVaultBaseUrl, err)
+	}
+
+	log.Printf("[DEBUG] Purging soft-deleted %s %q (Key Vault %q)", i.itemType, i.name, i.keyVaultBaseUrl)
+	if resp, err := i.purge(ctx); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error purging soft-deleted %s %q (Key Vault %q): %+v", i.itemType, i.name, i.keyVaultBaseUrl, err)
+		}
+	}
+
+	// the Purge is also asynchronous, and an item with the same name can't be created until it's completed
+	log.Printf("[DEBUG] Waiting for %s %q (Key Vault %q) to be purged", i.itemType, i.name, i.keyVaultBaseUrl)
+	stateConf = &resource.StateChangeConf{
+		Pending:                   []string{"Exists"},
+		Target:                    []string{"NotFound"},
+		Refresh:                   keyVaultSoftDeletedItemRefreshFunc(ctx, i.getDeleted),
+		Timeout:                   timeout,
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for %s %q (Key Vault %q) to be purged: %+v", i.itemType, i.name, i.keyVaultBaseUrl, err)
+	}
+
+	return nil
+}
+
+func keyVaultSoftDeletedItemRefreshFunc(ctx context.Context, get func(ctx context.Context) (autorest.Response, error)) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := get(ctx)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return "NotFound", "NotFound", nil
+			}
+
+			return nil, "", err
+		}
+
+		if resp.Response != nil && resp.StatusCode == http.StatusOK {
+			return "Exists", "Exists", nil
+		}
+
+		return "NotFound", "NotFound", nil
+	}
+}
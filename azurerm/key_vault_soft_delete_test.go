@@ -0,0 +1,132 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func testKeyVaultSoftDeletedItem(deletedStatusCode int, calls *[]string) keyVaultSoftDeletedItem {
+	response := func(statusCode int) (autorest.Response, error) {
+		resp := autorest.Response{Response: &http.Response{StatusCode: statusCode}}
+		if statusCode != http.StatusOK {
+			return resp, fmt.Errorf("returned %d", statusCode)
+		}
+		return resp, nil
+	}
+
+	return keyVaultSoftDeletedItem{
+		itemType:        "Secret",
+		name:            "example",
+		keyVaultBaseUrl: "https://example.vault.azure.net/",
+		get: func(ctx context.Context) (autorest.Response, error) {
+			*calls = append(*calls, "get")
+			return response(http.StatusOK)
+		},
+		getDeleted: func(ctx context.Context) (autorest.Response, error) {
+			*calls = append(*calls, "getDeleted")
+			return response(deletedStatusCode)
+		},
+		recover: func(ctx context.Context) error {
+			*calls = append(*calls, "recover")
+			return nil
+		},
+		purge: func(ctx context.Context) (autorest.Response, error) {
+			*calls = append(*calls, "purge")
+			return response(http.StatusNoContent)
+		},
+	}
+}
+
+func TestKeyVaultSoftDeletedItemRecover(t *testing.T) {
+	testData := []struct {
+		Name              string
+		DeletedStatusCode int
+		Features          keyVaultFeatures
+		ExpectError       bool
+		ExpectedCalls     int
+	}{
+		{
+			Name:              "Not soft-deleted",
+			DeletedStatusCode: http.StatusNotFound,
+			Features:          keyVaultFeatures{recoverSoftDeletedKeyVaults: true},
+			ExpectedCalls:     1,
+		},
+		{
+			Name:              "Soft Delete not enabled on the Key Vault",
+			DeletedStatusCode: http.StatusBadRequest,
+			Features:          keyVaultFeatures{recoverSoftDeletedKeyVaults: true},
+			ExpectedCalls:     1,
+		},
+		{
+			Name:              "Soft-deleted without recovery enabled",
+			DeletedStatusCode: http.StatusOK,
+			Features:          keyVaultFeatures{},
+			ExpectError:       true,
+			ExpectedCalls:     1,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		calls := make([]string, 0)
+		item := testKeyVaultSoftDeletedItem(v.DeletedStatusCode, &calls)
+		err := item.recoverIfSoftDeleted(context.TODO(), v.Features, time.Minute)
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if len(calls) != v.ExpectedCalls {
+			t.Fatalf("Expected %d calls but got %+v", v.ExpectedCalls, calls)
+		}
+	}
+}
+
+func TestKeyVaultSoftDeletedItemPurgeSkipped(t *testing.T) {
+	testData := []struct {
+		Name          string
+		Features      keyVaultFeatures
+		RecoveryId    *string
+		RecoveryLevel string
+	}{
+		{
+			Name:          "Purge not enabled",
+			Features:      keyVaultFeatures{},
+			RecoveryId:    utils.String("https://example.vault.azure.net/deletedsecrets/example"),
+			RecoveryLevel: "Recoverable+Purgeable",
+		},
+		{
+			Name:          "Soft Delete not enabled on the Key Vault",
+			Features:      keyVaultFeatures{purgeSoftDeleteOnDestroy: true},
+			RecoveryId:    nil,
+			RecoveryLevel: "Purgeable",
+		},
+		{
+			Name:          "Purge Protection enabled on the Key Vault",
+			Features:      keyVaultFeatures{purgeSoftDeleteOnDestroy: true},
+			RecoveryId:    utils.String("https://example.vault.azure.net/deletedsecrets/example"),
+			RecoveryLevel: "Recoverable",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		calls := make([]string, 0)
+		item := testKeyVaultSoftDeletedItem(http.StatusOK, &calls)
+		if err := item.purgeIfSoftDeleted(context.TODO(), v.Features, v.RecoveryId, v.RecoveryLevel, time.Minute); err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if len(calls) != 0 {
+			t.Fatalf("Expected no calls but got %+v", calls)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2019-02-01/containerservice"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tracing"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// kubernetesClusterVersionCustomizeDiff validates that a change to the `kubernetes_version` of an existing Managed
+// Kubernetes Cluster is a valid upgrade target (according to the Upgrade Profile for the Cluster) at plan time,
+// rather than the upgrade failing part-way through the apply
+func kubernetesClusterVersionCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("kubernetes_version") || !diff.NewValueKnown("kubernetes_version") {
+		return nil
+	}
+
+	requested := diff.Get("kubernetes_version").(string)
+	if requested == "" {
+		return nil
+	}
+
+	client := meta.(*ArmClient).kubernetesClustersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := diff.Get("name").(string)
+	resourceGroup := diff.Get("resource_group_name").(string)
+
+	profile, err := client.GetUpgradeProfile(ctx, resourceGroup, name)
+	if err != nil {
+		// the Cluster's been removed outside of Terraform, in which case it'll be re-created
+		if utils.ResponseWasNotFound(profile.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Upgrade Profile for Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	props := profile.ManagedClusterUpgradeProfileProperties
+	if props == nil || props.ControlPlaneProfile == nil || props.ControlPlaneProfile.KubernetesVersion == nil {
+		log.Printf("[DEBUG] Upgrade Profile for Managed Kubernetes Cluster %q (Resource Group %q) contained no Control Plane Profile - skipping validation of `kubernetes_version`", name, resourceGroup)
+		return nil
+	}
+
+	upgrades := make([]string, 0)
+	if props.ControlPlaneProfile.Upgrades != nil {
+		upgrades = *props.ControlPlaneProfile.Upgrades
+	}
+
+	if err := validateKubernetesClusterUpgrade(*props.ControlPlaneProfile.KubernetesVersion, upgrades, requested); err != nil {
+		return fmt.Errorf("Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// validateKubernetesClusterUpgrade ensures the requested version is either the current version or one of the
+// versions which the Cluster can be upgraded to
+func validateKubernetesClusterUpgrade(current string, upgrades []string, requested string) error {
+	if strings.EqualFold(current, requested) {
+		return nil
+	}
+
+	for _, v := range upgrades {
+		if strings.EqualFold(v, requested) {
+			return nil
+		}
+	}
+
+	if len(upgrades) == 0 {
+		return fmt.Errorf("`kubernetes_version` %q is not a valid upgrade from %q - no upgrades are currently available", requested, current)
+	}
+
+	available := make([]string, len(upgrades))
+	copy(available, upgrades)
+	sort.Strings(available)
+
+	return fmt.Errorf("`kubernetes_version` %q is not a valid upgrade from %q - possible values are %q", requested, current, strings.Join(available, ", "))
+}
+
+// kubernetesClusterUpgradeStep is a single step of upgrading a Managed Kubernetes Cluster
+type kubernetesClusterUpgradeStep struct {
+	// description is a description of this step, e.g. `Control Plane`
+	description string
+	run         func(ctx context.Context) error
+}
+
+// upgradeKubernetesCluster upgrades the Control Plane of a Managed Kubernetes Cluster to the specified version,
+// followed by each of the specified Node Pools (those defined in the `agent_pool_profile` block) one at a time.
+// Node Pools managed using the `azurerm_kubernetes_cluster_node_pool` resource are left on their current version.
+//
+// Clusters using Availability Sets can't upgrade the Control Plane and Node Pools independently, in which case
+// this is a no-op and the Cluster is upgraded as a whole by the subsequent update.
+func upgradeKubernetesCluster(ctx context.Context, meta interface{}, resourceGroup, name, kubernetesVersion string, nodePoolNames []string) error {
+	client := meta.(*ArmClient).kubernetesClustersClient
+
+	existing, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	props := existing.ManagedClusterProperties
+	if props == nil || props.KubernetesVersion == nil {
+		return fmt.Errorf("Error retrieving Managed Kubernetes Cluster %q (Resource Group %q): `properties` or `kubernetesVersion` was nil", name, resourceGroup)
+	}
+
+	currentVersion := *props.KubernetesVersion
+	if strings.EqualFold(currentVersion, kubernetesVersion) {
+		return nil
+	}
+
+	if !kubernetesClusterSupportsIndependentUpgrades(props.AgentPoolProfiles) {
+		log.Printf("[INFO] Managed Kubernetes Cluster %q (Resource Group %q) uses Availability Sets - the Control Plane and Node Pools will be upgraded from %q to %q together", name, resourceGroup, currentVersion, kubernetesVersion)
+		return nil
+	}
+
+	steps := []kubernetesClusterUpgradeStep{
+		{
+			description: "Control Plane",
+			run: func(ctx context.Context) error {
+				parameters := kubernetesClusterControlPlaneUpgradeParameters(existing, kubernetesVersion)
+				future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+				if err != nil {
+					return err
+				}
+
+				return future.WaitForCompletionRef(ctx, client.Client)
+			},
+		},
+	}
+
+	poolsClient := meta.(*ArmClient).kubernetesClusterNodePoolsClient
+	for _, v := range nodePoolNames {
+		poolName := v
+		steps = append(steps, kubernetesClusterUpgradeStep{
+			description: fmt.Sprintf("Node Pool %q", poolName),
+			run: func(ctx context.Context) error {
+				pool, err := poolsClient.Get(ctx, resourceGroup, name, poolName)
+				if err != nil {
+					return err
+				}
+
+				if pool.ManagedClusterAgentPoolProfileProperties == nil {
+					return fmt.Errorf("`properties` was nil")
+				}
+
+				pool.ManagedClusterAgentPoolProfileProperties.OrchestratorVersion = utils.String(kubernetesVersion)
+				future, err := poolsClient.CreateOrUpdate(ctx, resourceGroup, name, poolName, pool)
+				if err != nil {
+					return err
+				}
+
+				return future.WaitForCompletionRef(ctx, poolsClient.Client)
+			},
+		})
+	}
+
+	tracer := meta.(*ArmClient).tracer
+	for i, step := range steps {
+		log.Printf("[INFO] Upgrading %s of Managed Kubernetes Cluster %q (Resource Group %q) from %q to %q (step %d of %d)..", step.description, name, resourceGroup, currentVersion, kubernetesVersion, i+1, len(steps))
+		start := time.Now()
+
+		stepCtx := ctx
+		var span *tracing.Span
+		if tracer != nil {
+			stepCtx, span = tracer.Start(ctx, "azurerm_kubernetes_cluster.upgrade", tracing.SpanKindInternal)
+			span.SetAttribute("kubernetes.upgrade.target", step.description)
+			span.SetAttribute("kubernetes.upgrade.step", fmt.Sprintf("%d/%d", i+1, len(steps)))
+			span.SetAttribute("kubernetes.version", kubernetesVersion)
+		}
+
+		err := step.run(stepCtx)
+		if span != nil {
+			span.End(err)
+		}
+		if err != nil {
+			return fmt.Errorf("Error upgrading %s of Managed Kubernetes Cluster %q (Resource Group %q) from %q to %q (step %d of %d): %+v", step.description, name, resourceGroup, currentVersion, kubernetesVersion, i+1, len(steps), err)
+		}
+
+		log.Printf("[INFO] Upgraded %s of Managed Kubernetes Cluster %q (Resource Group %q) to %q in %s (step %d of %d)", step.description, name, resourceGroup, kubernetesVersion, time.Since(start).Round(time.Second), i+1, len(steps))
+	}
+
+	return nil
+}
+
+// kubernetesClusterSupportsIndependentUpgrades returns whether the Control Plane and Node Pools can be upgraded
+// independently, which is only supported when every Node Pool uses Virtual Machine Scale Sets
+func kubernetesClusterSupportsIndependentUpgrades(profiles *[]containerservice.ManagedClusterAgentPoolProfile) bool {
+	if profiles == nil {
+		return false
+	}
+
+	for _, profile := range *profiles {
+		if profile.Type != containerservice.VirtualMachineScaleSets {
+			return false
+		}
+	}
+
+	return true
+}
+
+// kubernetesClusterControlPlaneUpgradeParameters returns the existing Managed Kubernetes Cluster with the Control
+// Plane set to the specified version, and each Node Pool pinned to its current version - so that only the
+// Control Plane is upgraded
+func kubernetesClusterControlPlaneUpgradeParameters(existing containerservice.ManagedCluster, kubernetesVersion string) containerservice.ManagedCluster {
+	props := *existing.ManagedClusterProperties
+	currentVersion := props.KubernetesVersion
+	props.KubernetesVersion = utils.String(kubernetesVersion)
+
+	if props.AgentPoolProfiles != nil {
+		profiles := make([]containerservice.ManagedClusterAgentPoolProfile, 0)
+		for _, profile := range *props.AgentPoolProfiles {
+			if profile.OrchestratorVersion == nil || *profile.OrchestratorVersion == "" {
+				profile.OrchestratorVersion = currentVersion
+			}
+			profiles = append(profiles, profile)
+		}
+		props.AgentPoolProfiles = &profiles
+	}
+
+	return containerservice.ManagedCluster{
+		Name:                     existing.Name,
+		Location:                 existing.Location,
+		ManagedClusterProperties: &props,
+		Tags:                     existing.Tags,
+	}
+}
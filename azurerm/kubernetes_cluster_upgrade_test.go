@@ -0,0 +1,145 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2019-02-01/containerservice"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestValidateKubernetesClusterUpgrade(t *testing.T) {
+	testData := []struct {
+		Name        string
+		Current     string
+		Upgrades    []string
+		Requested   string
+		ExpectError bool
+	}{
+		{
+			Name:      "Current Version",
+			Current:   "1.12.8",
+			Upgrades:  []string{"1.13.5"},
+			Requested: "1.12.8",
+		},
+		{
+			Name:      "Available Upgrade",
+			Current:   "1.12.8",
+			Upgrades:  []string{"1.13.5", "1.12.9"},
+			Requested: "1.13.5",
+		},
+		{
+			Name:        "Skipping a Minor Version",
+			Current:     "1.12.8",
+			Upgrades:    []string{"1.13.5"},
+			Requested:   "1.14.0",
+			ExpectError: true,
+		},
+		{
+			Name:        "Downgrade",
+			Current:     "1.12.8",
+			Upgrades:    []string{"1.13.5"},
+			Requested:   "1.11.9",
+			ExpectError: true,
+		},
+		{
+			Name:        "No Upgrades Available",
+			Current:     "1.14.0",
+			Upgrades:    []string{},
+			Requested:   "1.15.0",
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := validateKubernetesClusterUpgrade(v.Current, v.Upgrades, v.Requested)
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	}
+}
+
+func TestKubernetesClusterSupportsIndependentUpgrades(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Profiles *[]containerservice.ManagedClusterAgentPoolProfile
+		Expected bool
+	}{
+		{
+			Name:     "No Profiles",
+			Profiles: nil,
+			Expected: false,
+		},
+		{
+			Name: "Availability Sets",
+			Profiles: &[]containerservice.ManagedClusterAgentPoolProfile{
+				{Type: containerservice.AvailabilitySet},
+			},
+			Expected: false,
+		},
+		{
+			Name: "Virtual Machine Scale Sets",
+			Profiles: &[]containerservice.ManagedClusterAgentPoolProfile{
+				{Type: containerservice.VirtualMachineScaleSets},
+				{Type: containerservice.VirtualMachineScaleSets},
+			},
+			Expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := kubernetesClusterSupportsIndependentUpgrades(v.Profiles)
+		if actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestKubernetesClusterControlPlaneUpgradeParameters(t *testing.T) {
+	existing := containerservice.ManagedCluster{
+		Name:     utils.String("example"),
+		Location: utils.String("westeurope"),
+		ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+			KubernetesVersion: utils.String("1.12.8"),
+			AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{
+				{
+					Name: utils.String("default"),
+				},
+				{
+					Name:                utils.String("internal"),
+					OrchestratorVersion: utils.String("1.11.9"),
+				},
+			},
+		},
+	}
+
+	actual := kubernetesClusterControlPlaneUpgradeParameters(existing, "1.13.5")
+
+	if v := *actual.ManagedClusterProperties.KubernetesVersion; v != "1.13.5" {
+		t.Fatalf("Expected the Control Plane to be upgraded to %q but got %q", "1.13.5", v)
+	}
+
+	expected := map[string]string{
+		"default":  "1.12.8",
+		"internal": "1.11.9",
+	}
+	for _, profile := range *actual.ManagedClusterProperties.AgentPoolProfiles {
+		if v := *profile.OrchestratorVersion; v != expected[*profile.Name] {
+			t.Fatalf("Expected Node Pool %q to be pinned to %q but got %q", *profile.Name, expected[*profile.Name], v)
+		}
+	}
+
+	// the existing Cluster shouldn't be modified
+	if v := *existing.ManagedClusterProperties.KubernetesVersion; v != "1.12.8" {
+		t.Fatalf("Expected the existing Cluster to be unchanged but got %q", v)
+	}
+	if v := (*existing.ManagedClusterProperties.AgentPoolProfiles)[0].OrchestratorVersion; v != nil {
+		t.Fatalf("Expected the existing Node Pool to be unchanged but got %q", *v)
+	}
+}
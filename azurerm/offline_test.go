@@ -0,0 +1,127 @@
+package azurerm
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	azureRest "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/armmock"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/recorder"
+)
+
+// the Subscription & Tenant ID's used when exercising resources against the fake Resource Manager API
+const (
+	offlineSubscriptionId = "00000000-0000-0000-0000-000000000000"
+	offlineTenantId       = "00000000-0000-0000-0000-000000000000"
+)
+
+// testOfflineArmClient returns an ArmClient which sends all requests to the specified endpoint using the
+// specified Transport, without authenticating - which allows the CRUD functions for a resource to be
+// exercised against the fake Resource Manager API in `helpers/armmock` or a recorded Cassette.
+func testOfflineArmClient(endpoint, subscriptionId string, transport http.RoundTripper) *ArmClient {
+	return testOfflineArmClientWithOptions(endpoint, subscriptionId, azure.SenderOptions{
+		Transport: transport,
+	})
+}
+
+// testOfflineArmClientWithOptions returns an ArmClient which sends all requests to the specified endpoint
+// using a Sender built from the specified options, without authenticating
+func testOfflineArmClientWithOptions(endpoint, subscriptionId string, senderOptions azure.SenderOptions) *ArmClient {
+	client := ArmClient{
+		subscriptionId:           subscriptionId,
+		tenantId:                 offlineTenantId,
+		environment:              azureRest.PublicCloud,
+		skipProviderRegistration: true,
+		StopContext:              context.Background(),
+		sender:                   azure.BuildSenderWithOptions(senderOptions),
+		tracer:                   senderOptions.Tracer,
+	}
+
+	auth := autorest.NullAuthorizer{}
+	client.registerClients(endpoint, endpoint, subscriptionId, offlineTenantId, auth, auth, auth)
+	return &client
+}
+
+// testRecordedArmClient returns an ArmClient which replays the Cassette with the specified name from
+// `testdata/recordings` - or when `ARM_TEST_RECORDER_MODE` is set to `record`, records a new Cassette
+// against the real Resource Manager API (which requires the same credentials as the Acceptance Tests).
+// The Location which was used for the recording is returned, along with a function which must be
+// called once the test has finished to save the recording.
+func testRecordedArmClient(t *testing.T, name string) (*ArmClient, string, func()) {
+	path := filepath.Join("testdata", "recordings", name+".json")
+
+	if recorder.ModeFromEnvironment() == recorder.Recording {
+		config := testGetAzureConfig(t)
+
+		rec, err := recorder.New(path, recorder.Recording, nil)
+		if err != nil {
+			t.Fatalf("Error creating Recorder: %+v", err)
+		}
+		rec.SetMetadata("subscription_id", config.SubscriptionID)
+		rec.SetMetadata("location", testLocation())
+
+		client, err := getArmClient(config, true, "", nil, azure.SenderOptions{Transport: rec})
+		if err != nil {
+			t.Fatalf("Error building ARM Client: %+v", err)
+		}
+		client.StopContext = testAccProvider.StopContext()
+
+		return client, testLocation(), func() {
+			if err := rec.Stop(); err != nil {
+				t.Fatalf("Error saving Cassette %q: %+v", path, err)
+			}
+		}
+	}
+
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		t.Skipf("Skipping since the Cassette %q hasn't been recorded", path)
+	}
+
+	rec, err := recorder.New(path, recorder.Replaying, nil)
+	if err != nil {
+		t.Fatalf("Error creating Recorder: %+v", err)
+	}
+
+	client := testOfflineArmClient(azureRest.PublicCloud.ResourceManagerEndpoint, rec.Metadata("subscription_id"), rec)
+	return client, rec.Metadata("location"), func() {}
+}
+
+func TestOfflineRecordAndReplay(t *testing.T) {
+	directory, err := ioutil.TempDir("", "recordings")
+	if err != nil {
+		t.Fatalf("Error creating temporary directory: %+v", err)
+	}
+	defer os.RemoveAll(directory)
+	path := filepath.Join(directory, "resource_group.json")
+
+	server := armmock.NewServer()
+	defer server.Close()
+
+	rec, err := recorder.New(path, recorder.Recording, server.Transport())
+	if err != nil {
+		t.Fatalf("Error creating Recorder: %+v", err)
+	}
+
+	client := testOfflineArmClient(server.URL, offlineSubscriptionId, rec)
+	testCheckAzureRMResourceGroupLifecycle(t, client, "westeurope")
+	if err := rec.Stop(); err != nil {
+		t.Fatalf("Error saving Cassette: %+v", err)
+	}
+
+	// the fake API is shut down to ensure the replay doesn't make any network calls
+	server.Close()
+
+	rec, err = recorder.New(path, recorder.Replaying, nil)
+	if err != nil {
+		t.Fatalf("Error creating Recorder: %+v", err)
+	}
+
+	client = testOfflineArmClient(azureRest.PublicCloud.ResourceManagerEndpoint, offlineSubscriptionId, rec)
+	testCheckAzureRMResourceGroupLifecycle(t, client, "westeurope")
+}
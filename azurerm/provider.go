@@ -5,12 +5,16 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"os"
 	"strings"
 
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 )
@@ -37,6 +41,16 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_TENANT_ID", ""),
 			},
 
+			"auxiliary_tenant_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: azure.MaxAuxiliaryTenants,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.UUID,
+				},
+			},
+
 			"environment": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -95,6 +109,58 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_SKIP_PROVIDER_REGISTRATION", false),
 			},
+
+			"lazy_provider_registration": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_LAZY_PROVIDER_REGISTRATION", false),
+			},
+
+			// Retries & Throttling
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_RETRIES", 5),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			// Tracing
+			"trace_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_TRACE_FILE", ""),
+			},
+
+			"trace_otlp_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_TRACE_OTLP_ENDPOINT", ""),
+			},
+
+			"features": schemaFeatures(),
+
+			// Tags
+			"default_tags": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validateAzureRMTags,
+			},
+
+			"ignore_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -307,6 +373,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_key_vault_secret":                                   resourceArmKeyVaultSecret(),
 			"azurerm_key_vault":                                          resourceArmKeyVault(),
 			"azurerm_kubernetes_cluster":                                 resourceArmKubernetesCluster(),
+			"azurerm_kubernetes_cluster_node_pool":                       resourceArmKubernetesClusterNodePool(),
 			"azurerm_lb_backend_address_pool":                            resourceArmLoadBalancerBackendAddressPool(),
 			"azurerm_lb_nat_pool":                                        resourceArmLoadBalancerNatPool(),
 			"azurerm_lb_nat_rule":                                        resourceArmLoadBalancerNatRule(),
@@ -345,6 +412,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_mysql_firewall_rule":                                resourceArmMySqlFirewallRule(),
 			"azurerm_mysql_server":                                       resourceArmMySqlServer(),
 			"azurerm_mysql_virtual_network_rule":                         resourceArmMySqlVirtualNetworkRule(),
+			"azurerm_nat_gateway":                                        resourceArmNatGateway(),
 			"azurerm_network_connection_monitor":                         resourceArmNetworkConnectionMonitor(),
 			"azurerm_network_ddos_protection_plan":                       resourceArmNetworkDDoSProtectionPlan(),
 			"azurerm_network_interface":                                  resourceArmNetworkInterface(),
@@ -369,6 +437,14 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_postgresql_firewall_rule":                                               resourceArmPostgreSQLFirewallRule(),
 			"azurerm_postgresql_server":                                                      resourceArmPostgreSQLServer(),
 			"azurerm_postgresql_virtual_network_rule":                                        resourceArmPostgreSQLVirtualNetworkRule(),
+			"azurerm_private_dns_a_record":                                                   resourceArmPrivateDnsARecord(),
+			"azurerm_private_dns_aaaa_record":                                                resourceArmPrivateDnsAaaaRecord(),
+			"azurerm_private_dns_cname_record":                                               resourceArmPrivateDnsCNameRecord(),
+			"azurerm_private_dns_ptr_record":                                                 resourceArmPrivateDnsPtrRecord(),
+			"azurerm_private_dns_srv_record":                                                 resourceArmPrivateDnsSrvRecord(),
+			"azurerm_private_dns_txt_record":                                                 resourceArmPrivateDnsTxtRecord(),
+			"azurerm_private_dns_zone":                                                       resourceArmPrivateDnsZone(),
+			"azurerm_private_dns_zone_virtual_network_link":                                  resourceArmPrivateDnsZoneVirtualNetworkLink(),
 			"azurerm_public_ip":                                                              resourceArmPublicIp(),
 			"azurerm_public_ip_prefix":                                                       resourceArmPublicIpPrefix(),
 			"azurerm_recovery_services_protected_vm":                                         resourceArmRecoveryServicesProtectedVm(),
@@ -411,6 +487,9 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_storage_account":                                                        resourceArmStorageAccount(),
 			"azurerm_storage_blob":                                                           resourceArmStorageBlob(),
 			"azurerm_storage_container":                                                      resourceArmStorageContainer(),
+			"azurerm_storage_data_lake_gen2_filesystem":                                      resourceArmStorageDataLakeGen2FileSystem(),
+			"azurerm_storage_data_lake_gen2_path":                                            resourceArmStorageDataLakeGen2Path(),
+			"azurerm_storage_management_policy":                                              resourceArmStorageManagementPolicy(),
 			"azurerm_storage_queue":                                                          resourceArmStorageQueue(),
 			"azurerm_storage_share":                                                          resourceArmStorageShare(),
 			"azurerm_storage_table":                                                          resourceArmStorageTable(),
@@ -423,6 +502,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_stream_analytics_stream_input_eventhub":                                 resourceArmStreamAnalyticsStreamInputEventHub(),
 			"azurerm_stream_analytics_stream_input_iothub":                                   resourceArmStreamAnalyticsStreamInputIoTHub(),
 			"azurerm_subnet_network_security_group_association":                              resourceArmSubnetNetworkSecurityGroupAssociation(),
+			"azurerm_subnet_nat_gateway_association":                                         resourceArmSubnetNatGatewayAssociation(),
 			"azurerm_subnet_route_table_association":                                         resourceArmSubnetRouteTableAssociation(),
 			"azurerm_subnet":                                                                 resourceArmSubnet(),
 			"azurerm_template_deployment":                                                    resourceArmTemplateDeployment(),
@@ -437,10 +517,14 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_virtual_network_gateway":                                                resourceArmVirtualNetworkGateway(),
 			"azurerm_virtual_network_peering":                                                resourceArmVirtualNetworkPeering(),
 			"azurerm_virtual_network":                                                        resourceArmVirtualNetwork(),
+			"azurerm_web_application_firewall_policy":                                        resourceArmWebApplicationFirewallPolicy(),
 		},
 	}
 
 	p.ConfigureFunc = providerConfigure(p)
+	applyTagsPolicy(p)
+	applyResourceProviderRegistration(p)
+	applyTracing(p)
 
 	return p
 }
@@ -471,13 +555,25 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 
 		partnerId := d.Get("partner_id").(string)
 		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
-		client, err := getArmClient(config, skipProviderRegistration, partnerId)
+		tracer, err := buildTracer(d.Get("trace_file").(string), d.Get("trace_otlp_endpoint").(string))
+		if err != nil {
+			return nil, fmt.Errorf("Error configuring tracing: %+v", err)
+		}
+		senderOptions := azure.SenderOptions{
+			MaxRetries:            d.Get("max_retries").(int),
+			MaxConcurrentRequests: d.Get("max_concurrent_requests").(int),
+			Tracer:                tracer,
+		}
+		auxiliaryTenantIds := expandAuxiliaryTenantIds(d.Get("auxiliary_tenant_ids").([]interface{}))
+		client, err := getArmClient(config, skipProviderRegistration, partnerId, auxiliaryTenantIds, senderOptions)
 
 		if err != nil {
 			return nil, err
 		}
 
 		client.StopContext = p.StopContext()
+		client.features = expandFeatures(d.Get("features").([]interface{}))
+		client.tagsPolicy = expandTagsPolicy(d.Get("default_tags").(map[string]interface{}), d.Get("ignore_tags").([]interface{}))
 
 		// replaces the context between tests
 		p.MetaReset = func() error {
@@ -485,6 +581,11 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			return nil
 		}
 
+		// when registering lazily, the Resource Providers needed by each Resource are registered the first time
+		// a Resource of that type is created, rather than registering every Resource Provider up-front
+		lazyProviderRegistration := d.Get("lazy_provider_registration").(bool)
+		var availableResourceProviders []resources.Provider
+
 		skipCredentialsValidation := d.Get("skip_credentials_validation").(bool)
 		if !skipCredentialsValidation {
 			// List all the available providers and their registration state to avoid unnecessary
@@ -496,9 +597,9 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 					"credentials or the service principal does not have permission to use the Resource Manager API, Azure "+
 					"error: %s", err)
 			}
+			availableResourceProviders = providerList.Values()
 
-			if !skipProviderRegistration {
-				availableResourceProviders := providerList.Values()
+			if !skipProviderRegistration && !lazyProviderRegistration {
 				requiredResourceProviders := requiredResourceProviders()
 
 				err := ensureResourceProvidersAreRegistered(ctx, client.providersClient, availableResourceProviders, requiredResourceProviders)
@@ -508,10 +609,33 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			}
 		}
 
+		if !skipProviderRegistration && lazyProviderRegistration {
+			client.resourceProviderRegistrar = newResourceProviderRegistrar(client.providersClient, availableResourceProviders)
+		}
+
 		return client, nil
 	}
 }
 
+func expandAuxiliaryTenantIds(input []interface{}) []string {
+	tenantIds := make([]string, 0)
+	for _, v := range input {
+		tenantIds = append(tenantIds, v.(string))
+	}
+
+	// when `auxiliary_tenant_ids` isn't specified these can be sourced from the `ARM_AUXILIARY_TENANT_IDS`
+	// Environment Variable, as a semi-colon separated list
+	if len(tenantIds) == 0 {
+		for _, v := range strings.Split(os.Getenv("ARM_AUXILIARY_TENANT_IDS"), ";") {
+			if v = strings.TrimSpace(v); v != "" {
+				tenantIds = append(tenantIds, v)
+			}
+		}
+	}
+
+	return tenantIds
+}
+
 // armMutexKV is the instance of MutexKV for ARM resources
 var armMutexKV = mutexkv.NewMutexKV()
 
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
@@ -29,6 +30,63 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProvider_resourceNamesHaveNamingRules(t *testing.T) {
+	// the names of these resources are restricted to a fixed set of values
+	ignored := map[string]bool{
+		"azurerm_dev_test_policy": true,
+	}
+
+	for resourceType, resource := range Provider().(*schema.Provider).ResourcesMap {
+		name, ok := resource.Schema["name"]
+		if !ok || ignored[resourceType] || (!name.Required && !name.Optional) {
+			continue
+		}
+
+		if _, ok := naming.RuleFor(resourceType); !ok {
+			t.Errorf("No naming rule is defined for %q", resourceType)
+		}
+	}
+}
+
+func TestProvider_resourcesDeclareResourceProviders(t *testing.T) {
+	resourceProviders := resourceProvidersForResources()
+	resourcesMap := Provider().(*schema.Provider).ResourcesMap
+
+	for resourceType := range resourcesMap {
+		if _, ok := resourceProviders[resourceType]; !ok {
+			t.Errorf("No Resource Providers are declared for %q", resourceType)
+		}
+	}
+
+	for resourceType := range resourceProviders {
+		if _, ok := resourcesMap[resourceType]; !ok {
+			t.Errorf("Resource Providers are declared for %q which isn't a Resource", resourceType)
+		}
+	}
+}
+
+func TestProvider_resourcesDeclareTimeouts(t *testing.T) {
+	for resourceType, resource := range Provider().(*schema.Provider).ResourcesMap {
+		if resource.Timeouts == nil {
+			t.Errorf("No Timeouts are declared for %q", resourceType)
+			continue
+		}
+
+		if resource.Create != nil && resource.Timeouts.Create == nil {
+			t.Errorf("No Create Timeout is declared for %q", resourceType)
+		}
+		if resource.Read != nil && resource.Timeouts.Read == nil {
+			t.Errorf("No Read Timeout is declared for %q", resourceType)
+		}
+		if resource.Update != nil && resource.Timeouts.Update == nil {
+			t.Errorf("No Update Timeout is declared for %q", resourceType)
+		}
+		if resource.Delete != nil && resource.Timeouts.Delete == nil {
+			t.Errorf("No Delete Timeout is declared for %q", resourceType)
+		}
+	}
+}
+
 func TestProvider_impl(t *testing.T) {
 	var _ = Provider()
 }
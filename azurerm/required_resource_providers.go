@@ -63,6 +63,290 @@ func requiredResourceProviders() map[string]struct{} {
 	}
 }
 
+// resourceProvidersForResources returns the Resource Providers (namespaces) which each Resource within the
+// AzureRM Provider needs to be registered in order to be provisioned - which allows the Resource Providers
+// to be registered on-demand (see `lazy_provider_registration`) rather than registering every Resource Provider
+// listed in `requiredResourceProviders` up-front.
+//
+// NOTE: Resource Providers in this list are case sensitive and new Resources must be added to this list
+func resourceProvidersForResources() map[string][]string {
+	return map[string][]string{
+		"azurerm_api_management":                                     {"Microsoft.ApiManagement"},
+		"azurerm_api_management_api":                                 {"Microsoft.ApiManagement"},
+		"azurerm_api_management_api_operation":                       {"Microsoft.ApiManagement"},
+		"azurerm_api_management_api_operation_policy":                {"Microsoft.ApiManagement"},
+		"azurerm_api_management_api_policy":                          {"Microsoft.ApiManagement"},
+		"azurerm_api_management_api_schema":                          {"Microsoft.ApiManagement"},
+		"azurerm_api_management_api_version_set":                     {"Microsoft.ApiManagement"},
+		"azurerm_api_management_authorization_server":                {"Microsoft.ApiManagement"},
+		"azurerm_api_management_certificate":                         {"Microsoft.ApiManagement"},
+		"azurerm_api_management_group":                               {"Microsoft.ApiManagement"},
+		"azurerm_api_management_group_user":                          {"Microsoft.ApiManagement"},
+		"azurerm_api_management_logger":                              {"Microsoft.ApiManagement"},
+		"azurerm_api_management_openid_connect_provider":             {"Microsoft.ApiManagement"},
+		"azurerm_api_management_product":                             {"Microsoft.ApiManagement"},
+		"azurerm_api_management_product_api":                         {"Microsoft.ApiManagement"},
+		"azurerm_api_management_product_group":                       {"Microsoft.ApiManagement"},
+		"azurerm_api_management_product_policy":                      {"Microsoft.ApiManagement"},
+		"azurerm_api_management_property":                            {"Microsoft.ApiManagement"},
+		"azurerm_api_management_subscription":                        {"Microsoft.ApiManagement"},
+		"azurerm_api_management_user":                                {"Microsoft.ApiManagement"},
+		"azurerm_app_service":                                        {"Microsoft.Web"},
+		"azurerm_app_service_active_slot":                            {"Microsoft.Web"},
+		"azurerm_app_service_custom_hostname_binding":                {"Microsoft.Web"},
+		"azurerm_app_service_plan":                                   {"Microsoft.Web"},
+		"azurerm_app_service_slot":                                   {"Microsoft.Web"},
+		"azurerm_application_gateway":                                {"Microsoft.Network"},
+		"azurerm_application_insights":                               {"microsoft.insights"},
+		"azurerm_application_insights_api_key":                       {"microsoft.insights"},
+		"azurerm_application_insights_web_test":                      {"microsoft.insights"},
+		"azurerm_application_security_group":                         {"Microsoft.Network"},
+		"azurerm_automation_account":                                 {"Microsoft.Automation"},
+		"azurerm_automation_credential":                              {"Microsoft.Automation"},
+		"azurerm_automation_dsc_configuration":                       {"Microsoft.Automation"},
+		"azurerm_automation_dsc_nodeconfiguration":                   {"Microsoft.Automation"},
+		"azurerm_automation_module":                                  {"Microsoft.Automation"},
+		"azurerm_automation_runbook":                                 {"Microsoft.Automation"},
+		"azurerm_automation_schedule":                                {"Microsoft.Automation"},
+		"azurerm_automation_variable_bool":                           {"Microsoft.Automation"},
+		"azurerm_automation_variable_datetime":                       {"Microsoft.Automation"},
+		"azurerm_automation_variable_int":                            {"Microsoft.Automation"},
+		"azurerm_automation_variable_string":                         {"Microsoft.Automation"},
+		"azurerm_autoscale_setting":                                  {"microsoft.insights"},
+		"azurerm_availability_set":                                   {"Microsoft.Compute"},
+		"azurerm_azuread_application":                                {},
+		"azurerm_azuread_service_principal":                          {},
+		"azurerm_azuread_service_principal_password":                 {},
+		"azurerm_batch_account":                                      {"Microsoft.Batch"},
+		"azurerm_batch_certificate":                                  {"Microsoft.Batch"},
+		"azurerm_batch_pool":                                         {"Microsoft.Batch"},
+		"azurerm_cdn_endpoint":                                       {"Microsoft.Cdn"},
+		"azurerm_cdn_profile":                                        {"Microsoft.Cdn"},
+		"azurerm_cognitive_account":                                  {"Microsoft.CognitiveServices"},
+		"azurerm_connection_monitor":                                 {"Microsoft.Network"},
+		"azurerm_container_group":                                    {"Microsoft.ContainerInstance"},
+		"azurerm_container_registry":                                 {"Microsoft.ContainerRegistry"},
+		"azurerm_container_service":                                  {"Microsoft.ContainerService"},
+		"azurerm_cosmosdb_account":                                   {"Microsoft.DocumentDB"},
+		"azurerm_cosmosdb_cassandra_keyspace":                        {"Microsoft.DocumentDB"},
+		"azurerm_cosmosdb_mongo_collection":                          {"Microsoft.DocumentDB"},
+		"azurerm_cosmosdb_mongo_database":                            {"Microsoft.DocumentDB"},
+		"azurerm_cosmosdb_sql_database":                              {"Microsoft.DocumentDB"},
+		"azurerm_cosmosdb_table":                                     {"Microsoft.DocumentDB"},
+		"azurerm_data_factory":                                       {"Microsoft.DataFactory"},
+		"azurerm_data_factory_dataset_mysql":                         {"Microsoft.DataFactory"},
+		"azurerm_data_factory_dataset_postgresql":                    {"Microsoft.DataFactory"},
+		"azurerm_data_factory_dataset_sql_server_table":              {"Microsoft.DataFactory"},
+		"azurerm_data_factory_linked_service_data_lake_storage_gen2": {"Microsoft.DataFactory"},
+		"azurerm_data_factory_linked_service_mysql":                  {"Microsoft.DataFactory"},
+		"azurerm_data_factory_linked_service_postgresql":             {"Microsoft.DataFactory"},
+		"azurerm_data_factory_linked_service_sql_server":             {"Microsoft.DataFactory"},
+		"azurerm_data_factory_pipeline":                              {"Microsoft.DataFactory"},
+		"azurerm_data_lake_analytics_account":                        {"Microsoft.DataLakeAnalytics"},
+		"azurerm_data_lake_analytics_firewall_rule":                  {"Microsoft.DataLakeAnalytics"},
+		"azurerm_data_lake_store":                                    {"Microsoft.DataLakeStore"},
+		"azurerm_data_lake_store_file":                               {"Microsoft.DataLakeStore"},
+		"azurerm_data_lake_store_firewall_rule":                      {"Microsoft.DataLakeStore"},
+		"azurerm_databricks_workspace":                               {"Microsoft.Databricks"},
+		"azurerm_ddos_protection_plan":                               {"Microsoft.Network"},
+		"azurerm_dev_test_lab":                                       {"Microsoft.DevTestLab"},
+		"azurerm_dev_test_linux_virtual_machine":                     {"Microsoft.DevTestLab"},
+		"azurerm_dev_test_policy":                                    {"Microsoft.DevTestLab"},
+		"azurerm_dev_test_virtual_network":                           {"Microsoft.DevTestLab"},
+		"azurerm_dev_test_windows_virtual_machine":                   {"Microsoft.DevTestLab"},
+		"azurerm_devspace_controller":                                {"Microsoft.DevSpaces"},
+		"azurerm_dns_a_record":                                       {"Microsoft.Network"},
+		"azurerm_dns_aaaa_record":                                    {"Microsoft.Network"},
+		"azurerm_dns_caa_record":                                     {"Microsoft.Network"},
+		"azurerm_dns_cname_record":                                   {"Microsoft.Network"},
+		"azurerm_dns_mx_record":                                      {"Microsoft.Network"},
+		"azurerm_dns_ns_record":                                      {"Microsoft.Network"},
+		"azurerm_dns_ptr_record":                                     {"Microsoft.Network"},
+		"azurerm_dns_srv_record":                                     {"Microsoft.Network"},
+		"azurerm_dns_txt_record":                                     {"Microsoft.Network"},
+		"azurerm_dns_zone":                                           {"Microsoft.Network"},
+		"azurerm_eventgrid_domain":                                   {"Microsoft.EventGrid"},
+		"azurerm_eventgrid_event_subscription":                       {"Microsoft.EventGrid"},
+		"azurerm_eventgrid_topic":                                    {"Microsoft.EventGrid"},
+		"azurerm_eventhub":                                           {"Microsoft.EventHub"},
+		"azurerm_eventhub_authorization_rule":                        {"Microsoft.EventHub"},
+		"azurerm_eventhub_consumer_group":                            {"Microsoft.EventHub"},
+		"azurerm_eventhub_namespace":                                 {"Microsoft.EventHub"},
+		"azurerm_eventhub_namespace_authorization_rule":              {"Microsoft.EventHub"},
+		"azurerm_express_route_circuit":                              {"Microsoft.Network"},
+		"azurerm_express_route_circuit_authorization":                {"Microsoft.Network"},
+		"azurerm_express_route_circuit_peering":                      {"Microsoft.Network"},
+		"azurerm_firewall":                                           {"Microsoft.Network"},
+		"azurerm_firewall_application_rule_collection":               {"Microsoft.Network"},
+		"azurerm_firewall_nat_rule_collection":                       {"Microsoft.Network"},
+		"azurerm_firewall_network_rule_collection":                   {"Microsoft.Network"},
+		"azurerm_function_app":                                       {"Microsoft.Web"},
+		"azurerm_hdinsight_hadoop_cluster":                           {"Microsoft.HDInsight"},
+		"azurerm_hdinsight_hbase_cluster":                            {"Microsoft.HDInsight"},
+		"azurerm_hdinsight_interactive_query_cluster":                {"Microsoft.HDInsight"},
+		"azurerm_hdinsight_kafka_cluster":                            {"Microsoft.HDInsight"},
+		"azurerm_hdinsight_ml_services_cluster":                      {"Microsoft.HDInsight"},
+		"azurerm_hdinsight_rserver_cluster":                          {"Microsoft.HDInsight"},
+		"azurerm_hdinsight_spark_cluster":                            {"Microsoft.HDInsight"},
+		"azurerm_hdinsight_storm_cluster":                            {"Microsoft.HDInsight"},
+		"azurerm_image":                                              {"Microsoft.Compute"},
+		"azurerm_iothub":                                             {"Microsoft.Devices"},
+		"azurerm_iothub_consumer_group":                              {"Microsoft.Devices"},
+		"azurerm_iothub_shared_access_policy":                        {"Microsoft.Devices"},
+		"azurerm_key_vault":                                          {"Microsoft.KeyVault"},
+		"azurerm_key_vault_access_policy":                            {"Microsoft.KeyVault"},
+		"azurerm_key_vault_certificate":                              {"Microsoft.KeyVault"},
+		"azurerm_key_vault_key":                                      {"Microsoft.KeyVault"},
+		"azurerm_key_vault_secret":                                   {"Microsoft.KeyVault"},
+		"azurerm_kubernetes_cluster":                                 {"Microsoft.ContainerService"},
+		"azurerm_kubernetes_cluster_node_pool":                       {"Microsoft.ContainerService"},
+		"azurerm_lb":                                                 {"Microsoft.Network"},
+		"azurerm_lb_backend_address_pool":                            {"Microsoft.Network"},
+		"azurerm_lb_nat_pool":                                        {"Microsoft.Network"},
+		"azurerm_lb_nat_rule":                                        {"Microsoft.Network"},
+		"azurerm_lb_outbound_rule":                                   {"Microsoft.Network"},
+		"azurerm_lb_probe":                                           {"Microsoft.Network"},
+		"azurerm_lb_rule":                                            {"Microsoft.Network"},
+		"azurerm_local_network_gateway":                              {"Microsoft.Network"},
+		"azurerm_log_analytics_linked_service":                       {"Microsoft.OperationalInsights"},
+		"azurerm_log_analytics_solution":                             {"Microsoft.OperationsManagement"},
+		"azurerm_log_analytics_workspace":                            {"Microsoft.OperationalInsights"},
+		"azurerm_log_analytics_workspace_linked_service":             {"Microsoft.OperationalInsights"},
+		"azurerm_logic_app_action_custom":                            {"Microsoft.Logic"},
+		"azurerm_logic_app_action_http":                              {"Microsoft.Logic"},
+		"azurerm_logic_app_trigger_custom":                           {"Microsoft.Logic"},
+		"azurerm_logic_app_trigger_http_request":                     {"Microsoft.Logic"},
+		"azurerm_logic_app_trigger_recurrence":                       {"Microsoft.Logic"},
+		"azurerm_logic_app_workflow":                                 {"Microsoft.Logic"},
+		"azurerm_managed_disk":                                       {"Microsoft.Compute"},
+		"azurerm_management_group":                                   {"Microsoft.Management"},
+		"azurerm_management_lock":                                    {"Microsoft.Authorization"},
+		"azurerm_mariadb_database":                                   {"Microsoft.DBforMariaDB"},
+		"azurerm_mariadb_server":                                     {"Microsoft.DBforMariaDB"},
+		"azurerm_media_services_account":                             {"Microsoft.Media"},
+		"azurerm_metric_alertrule":                                   {"microsoft.insights"},
+		"azurerm_monitor_action_group":                               {"microsoft.insights"},
+		"azurerm_monitor_activity_log_alert":                         {"microsoft.insights"},
+		"azurerm_monitor_autoscale_setting":                          {"microsoft.insights"},
+		"azurerm_monitor_diagnostic_setting":                         {"microsoft.insights"},
+		"azurerm_monitor_log_profile":                                {"microsoft.insights"},
+		"azurerm_monitor_metric_alert":                               {"microsoft.insights"},
+		"azurerm_monitor_metric_alertrule":                           {"microsoft.insights"},
+		"azurerm_mssql_elasticpool":                                  {"Microsoft.Sql"},
+		"azurerm_mysql_configuration":                                {"Microsoft.DBforMySQL"},
+		"azurerm_mysql_database":                                     {"Microsoft.DBforMySQL"},
+		"azurerm_mysql_firewall_rule":                                {"Microsoft.DBforMySQL"},
+		"azurerm_mysql_server":                                       {"Microsoft.DBforMySQL"},
+		"azurerm_mysql_virtual_network_rule":                         {"Microsoft.DBforMySQL"},
+		"azurerm_nat_gateway":                                        {"Microsoft.Network"},
+		"azurerm_network_connection_monitor":                         {"Microsoft.Network"},
+		"azurerm_network_ddos_protection_plan":                       {"Microsoft.Network"},
+		"azurerm_network_interface":                                  {"Microsoft.Network"},
+		"azurerm_network_interface_application_gateway_backend_address_pool_association": {"Microsoft.Network"},
+		"azurerm_network_interface_application_security_group_association":               {"Microsoft.Network"},
+		"azurerm_network_interface_backend_address_pool_association":                     {"Microsoft.Network"},
+		"azurerm_network_interface_nat_rule_association":                                 {"Microsoft.Network"},
+		"azurerm_network_packet_capture":                                                 {"Microsoft.Network"},
+		"azurerm_network_profile":                                                        {"Microsoft.Network"},
+		"azurerm_network_security_group":                                                 {"Microsoft.Network"},
+		"azurerm_network_security_rule":                                                  {"Microsoft.Network"},
+		"azurerm_network_watcher":                                                        {"Microsoft.Network"},
+		"azurerm_notification_hub":                                                       {"Microsoft.NotificationHubs"},
+		"azurerm_notification_hub_authorization_rule":                                    {"Microsoft.NotificationHubs"},
+		"azurerm_notification_hub_namespace":                                             {"Microsoft.NotificationHubs"},
+		"azurerm_packet_capture":                                                         {"Microsoft.Network"},
+		"azurerm_policy_assignment":                                                      {"Microsoft.Authorization"},
+		"azurerm_policy_definition":                                                      {"Microsoft.Authorization"},
+		"azurerm_policy_set_definition":                                                  {"Microsoft.Authorization"},
+		"azurerm_postgresql_configuration":                                               {"Microsoft.DBforPostgreSQL"},
+		"azurerm_postgresql_database":                                                    {"Microsoft.DBforPostgreSQL"},
+		"azurerm_postgresql_firewall_rule":                                               {"Microsoft.DBforPostgreSQL"},
+		"azurerm_postgresql_server":                                                      {"Microsoft.DBforPostgreSQL"},
+		"azurerm_postgresql_virtual_network_rule":                                        {"Microsoft.DBforPostgreSQL"},
+		"azurerm_private_dns_a_record":                                                   {"Microsoft.Network"},
+		"azurerm_private_dns_aaaa_record":                                                {"Microsoft.Network"},
+		"azurerm_private_dns_cname_record":                                               {"Microsoft.Network"},
+		"azurerm_private_dns_ptr_record":                                                 {"Microsoft.Network"},
+		"azurerm_private_dns_srv_record":                                                 {"Microsoft.Network"},
+		"azurerm_private_dns_txt_record":                                                 {"Microsoft.Network"},
+		"azurerm_private_dns_zone":                                                       {"Microsoft.Network"},
+		"azurerm_private_dns_zone_virtual_network_link":                                  {"Microsoft.Network"},
+		"azurerm_public_ip":                                                              {"Microsoft.Network"},
+		"azurerm_public_ip_prefix":                                                       {"Microsoft.Network"},
+		"azurerm_recovery_services_protected_vm":                                         {"Microsoft.RecoveryServices"},
+		"azurerm_recovery_services_protection_policy_vm":                                 {"Microsoft.RecoveryServices"},
+		"azurerm_recovery_services_vault":                                                {"Microsoft.RecoveryServices"},
+		"azurerm_redis_cache":                                                            {"Microsoft.Cache"},
+		"azurerm_redis_firewall_rule":                                                    {"Microsoft.Cache"},
+		"azurerm_relay_namespace":                                                        {"Microsoft.Relay"},
+		"azurerm_resource_group":                                                         {"Microsoft.Resources"},
+		"azurerm_role_assignment":                                                        {"Microsoft.Authorization"},
+		"azurerm_role_definition":                                                        {"Microsoft.Authorization"},
+		"azurerm_route":                                                                  {"Microsoft.Network"},
+		"azurerm_route_table":                                                            {"Microsoft.Network"},
+		"azurerm_scheduler_job":                                                          {"Microsoft.Scheduler"},
+		"azurerm_scheduler_job_collection":                                               {"Microsoft.Scheduler"},
+		"azurerm_search_service":                                                         {"Microsoft.Search"},
+		"azurerm_security_center_contact":                                                {"Microsoft.Security"},
+		"azurerm_security_center_subscription_pricing":                                   {"Microsoft.Security"},
+		"azurerm_security_center_workspace":                                              {"Microsoft.Security"},
+		"azurerm_service_fabric_cluster":                                                 {"Microsoft.ServiceFabric"},
+		"azurerm_servicebus_namespace":                                                   {"Microsoft.ServiceBus"},
+		"azurerm_servicebus_namespace_authorization_rule":                                {"Microsoft.ServiceBus"},
+		"azurerm_servicebus_queue":                                                       {"Microsoft.ServiceBus"},
+		"azurerm_servicebus_queue_authorization_rule":                                    {"Microsoft.ServiceBus"},
+		"azurerm_servicebus_subscription":                                                {"Microsoft.ServiceBus"},
+		"azurerm_servicebus_subscription_rule":                                           {"Microsoft.ServiceBus"},
+		"azurerm_servicebus_topic":                                                       {"Microsoft.ServiceBus"},
+		"azurerm_servicebus_topic_authorization_rule":                                    {"Microsoft.ServiceBus"},
+		"azurerm_shared_image":                                                           {"Microsoft.Compute"},
+		"azurerm_shared_image_gallery":                                                   {"Microsoft.Compute"},
+		"azurerm_shared_image_version":                                                   {"Microsoft.Compute"},
+		"azurerm_signalr_service":                                                        {"Microsoft.SignalRService"},
+		"azurerm_snapshot":                                                               {"Microsoft.Compute"},
+		"azurerm_sql_active_directory_administrator":                                     {"Microsoft.Sql"},
+		"azurerm_sql_database":                                                           {"Microsoft.Sql"},
+		"azurerm_sql_elasticpool":                                                        {"Microsoft.Sql"},
+		"azurerm_sql_firewall_rule":                                                      {"Microsoft.Sql"},
+		"azurerm_sql_server":                                                             {"Microsoft.Sql"},
+		"azurerm_sql_virtual_network_rule":                                               {"Microsoft.Sql"},
+		"azurerm_storage_account":                                                        {"Microsoft.Storage"},
+		"azurerm_storage_blob":                                                           {"Microsoft.Storage"},
+		"azurerm_storage_container":                                                      {"Microsoft.Storage"},
+		"azurerm_storage_data_lake_gen2_filesystem":                                      {"Microsoft.Storage"},
+		"azurerm_storage_data_lake_gen2_path":                                            {"Microsoft.Storage"},
+		"azurerm_storage_management_policy":                                              {"Microsoft.Storage"},
+		"azurerm_storage_queue":                                                          {"Microsoft.Storage"},
+		"azurerm_storage_share":                                                          {"Microsoft.Storage"},
+		"azurerm_storage_table":                                                          {"Microsoft.Storage"},
+		"azurerm_stream_analytics_function_javascript_udf":                               {"Microsoft.StreamAnalytics"},
+		"azurerm_stream_analytics_job":                                                   {"Microsoft.StreamAnalytics"},
+		"azurerm_stream_analytics_output_blob":                                           {"Microsoft.StreamAnalytics"},
+		"azurerm_stream_analytics_output_eventhub":                                       {"Microsoft.StreamAnalytics"},
+		"azurerm_stream_analytics_output_servicebus_queue":                               {"Microsoft.StreamAnalytics"},
+		"azurerm_stream_analytics_stream_input_blob":                                     {"Microsoft.StreamAnalytics"},
+		"azurerm_stream_analytics_stream_input_eventhub":                                 {"Microsoft.StreamAnalytics"},
+		"azurerm_stream_analytics_stream_input_iothub":                                   {"Microsoft.StreamAnalytics"},
+		"azurerm_subnet":                                                                 {"Microsoft.Network"},
+		"azurerm_subnet_nat_gateway_association":                                         {"Microsoft.Network"},
+		"azurerm_subnet_network_security_group_association":                              {"Microsoft.Network"},
+		"azurerm_subnet_route_table_association":                                         {"Microsoft.Network"},
+		"azurerm_template_deployment":                                                    {"Microsoft.Resources"},
+		"azurerm_traffic_manager_endpoint":                                               {"Microsoft.Network"},
+		"azurerm_traffic_manager_profile":                                                {"Microsoft.Network"},
+		"azurerm_user_assigned_identity":                                                 {"Microsoft.ManagedIdentity"},
+		"azurerm_virtual_machine":                                                        {"Microsoft.Compute", "Microsoft.Network"},
+		"azurerm_virtual_machine_data_disk_attachment":                                   {"Microsoft.Compute"},
+		"azurerm_virtual_machine_extension":                                              {"Microsoft.Compute"},
+		"azurerm_virtual_machine_scale_set":                                              {"Microsoft.Compute"},
+		"azurerm_virtual_network":                                                        {"Microsoft.Network"},
+		"azurerm_virtual_network_gateway":                                                {"Microsoft.Network"},
+		"azurerm_virtual_network_gateway_connection":                                     {"Microsoft.Network"},
+		"azurerm_virtual_network_peering":                                                {"Microsoft.Network"},
+		"azurerm_web_application_firewall_policy":                                        {"Microsoft.Network"},
+	}
+}
+
 func ensureResourceProvidersAreRegistered(ctx context.Context, client resources.ProvidersClient, availableRPs []resources.Provider, requiredRPs map[string]struct{}) error {
 	log.Printf("[DEBUG] Determining which Resource Providers require Registration")
 	providersToRegister := resourceproviders.DetermineResourceProvidersRequiringRegistration(availableRPs, requiredRPs)
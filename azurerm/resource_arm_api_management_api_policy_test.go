@@ -37,7 +37,7 @@ func TestAccAzureRMApiManagementAPIPolicy_basic(t *testing.T) {
 }
 
 func TestAccAzureRMApiManagementAPIPolicy_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -36,7 +36,7 @@ func TestAccAzureRMApiManagementApiSchema_basic(t *testing.T) {
 }
 
 func TestAccAzureRMApiManagementApiSchema_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
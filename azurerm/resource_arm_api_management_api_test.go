@@ -65,7 +65,7 @@ func TestAccAzureRMApiManagementApi_wordRevision(t *testing.T) {
 }
 
 func TestAccAzureRMApiManagementApi_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -41,7 +41,7 @@ func TestAccAzureRMApiManagementLogger_basicEventHub(t *testing.T) {
 }
 
 func TestAccAzureRMApiManagementLogger_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -36,7 +36,7 @@ func TestAccAzureRMAPIManagementProductApi_basic(t *testing.T) {
 }
 
 func TestAccAzureRMAPIManagementProductApi_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -43,7 +43,7 @@ func TestAccAzureRMApiManagementProduct_basic(t *testing.T) {
 }
 
 func TestAccAzureRMApiManagementProduct_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -38,7 +38,7 @@ func TestAccAzureRMApiManagementUser_basic(t *testing.T) {
 }
 
 func TestAccAzureRMApiManagementUser_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
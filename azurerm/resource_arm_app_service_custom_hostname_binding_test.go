@@ -75,7 +75,7 @@ func testAccAzureRMAppServiceCustomHostnameBinding_basic(t *testing.T, appServic
 }
 
 func testAccAzureRMAppServiceCustomHostnameBinding_requiresImport(t *testing.T, appServiceEnv, domainEnv string) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
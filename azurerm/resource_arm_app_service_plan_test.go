@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -42,7 +43,7 @@ func TestAzureRMAppServicePlanName_validation(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		_, errors := validateAppServicePlanName(tc.Value, "azurerm_app_service_plan")
+		_, errors := naming.Validate("azurerm_app_service_plan")(tc.Value, "azurerm_app_service_plan")
 
 		if len(errors) != tc.ErrCount {
 			t.Fatalf("Expected the App Service Plan Name to trigger a validation error for '%s'", tc.Value)
@@ -109,7 +110,7 @@ func TestAccAzureRMAppServicePlan_basicLinux(t *testing.T) {
 }
 
 func TestAccAzureRMAppServicePlan_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
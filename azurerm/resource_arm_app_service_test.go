@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -44,7 +45,7 @@ func TestAzureRMAppServiceName_validation(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		_, errors := validateAppServiceName(tc.Value, "azurerm_app_service")
+		_, errors := naming.Validate("azurerm_app_service")(tc.Value, "azurerm_app_service")
 
 		if len(errors) != tc.ErrCount {
 			t.Fatalf("Expected the App Service Name to trigger a validation error for '%s'", tc.Value)
@@ -80,7 +81,7 @@ func TestAccAzureRMAppService_basic(t *testing.T) {
 }
 
 func TestAccAzureRMAppService_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -37,7 +37,7 @@ func TestAccAzureRMApplicationInsights_basicWeb(t *testing.T) {
 }
 
 func TestAccAzureRMApplicationInsights_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
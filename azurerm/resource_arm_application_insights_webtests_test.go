@@ -101,7 +101,7 @@ func TestAccAzureRMApplicationInsightsWebTests_update(t *testing.T) {
 }
 
 func TestAccAzureRMApplicationInsightsWebTests_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -39,7 +39,7 @@ func TestAccAzureRMAutomationAccount_basic(t *testing.T) {
 }
 
 func TestAccAzureRMAutomationAccount_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -40,7 +40,7 @@ func TestAccAzureRMAutomationDscConfiguration_basic(t *testing.T) {
 }
 
 func TestAccAzureRMAutomationDscConfiguration_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
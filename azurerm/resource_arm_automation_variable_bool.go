@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"github.com/hashicorp/terraform/helper/schema"
+	"time"
 )
 
 func resourceArmAutomationVariableBool() *schema.Resource {
@@ -15,7 +16,14 @@ func resourceArmAutomationVariableBool() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		Schema: resourceAutomationVariableCommonSchema(schema.TypeBool, nil),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: resourceAutomationVariableCommonSchema("azurerm_automation_variable_bool", schema.TypeBool, nil),
 	}
 }
 
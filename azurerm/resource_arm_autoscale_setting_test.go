@@ -43,7 +43,7 @@ func TestAccAzureRMAutoScaleSetting_basic(t *testing.T) {
 }
 
 func TestAccAzureRMAutoScaleSetting_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
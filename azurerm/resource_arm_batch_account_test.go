@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -26,7 +27,7 @@ func TestValidateBatchAccountName(t *testing.T) {
 	}
 
 	for _, test := range testCases {
-		_, es := validateAzureRMBatchAccountName(test.input, "name")
+		_, es := naming.Validate("azurerm_batch_account")(test.input, "name")
 
 		if test.shouldError && len(es) == 0 {
 			t.Fatalf("Expected validating name %q to fail", test.input)
@@ -63,7 +64,7 @@ func TestAccAzureRMBatchAccount_basic(t *testing.T) {
 }
 
 func TestAccAzureRMBatchAccount_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
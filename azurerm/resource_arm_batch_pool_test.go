@@ -46,7 +46,7 @@ func TestAccAzureRMBatchPool_basic(t *testing.T) {
 }
 
 func TestAccAzureRMBatchPool_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -36,7 +36,7 @@ func TestAccAzureRMCdnEndpoint_basic(t *testing.T) {
 }
 
 func TestAccAzureRMCdnEndpoint_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -43,7 +43,7 @@ func TestAccAzureRMDataLakeStoreFirewallRule_basic(t *testing.T) {
 //
 
 func TestAccAzureRMDataLakeStoreFirewallRule_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
@@ -54,7 +55,7 @@ func TestAzureRMDatabrickWorkspaceName(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		_, errors := validateDatabricksWorkspaceName(tc.Value, "test")
+		_, errors := naming.Validate("azurerm_databricks_workspace")(tc.Value, "test")
 
 		hasErrors := len(errors) > 0
 		if hasErrors && !tc.ShouldError {
@@ -93,7 +94,7 @@ func TestAccAzureRMDatabricksWorkspace_basic(t *testing.T) {
 }
 
 func TestAccAzureRMDatabricksWorkspace_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
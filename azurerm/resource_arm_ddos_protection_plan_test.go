@@ -37,7 +37,7 @@ func testAccAzureRMDDoSProtectionPlan_basic(t *testing.T) {
 }
 
 func testAccAzureRMDDoSProtectionPlan_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
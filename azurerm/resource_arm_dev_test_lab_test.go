@@ -38,7 +38,7 @@ func TestAccAzureRMDevTestLab_basic(t *testing.T) {
 }
 
 func TestAccAzureRMDevTestLab_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
@@ -20,7 +21,7 @@ func TestValidateDevTestVirtualNetworkName(t *testing.T) {
 		"double-hyphen--valid",
 	}
 	for _, v := range validNames {
-		_, errors := validateDevTestVirtualNetworkName()(v, "example")
+		_, errors := naming.Validate("azurerm_dev_test_virtual_network")(v, "example")
 		if len(errors) != 0 {
 			t.Fatalf("%q should be a valid Dev Test Virtual Network Name: %q", v, errors)
 		}
@@ -31,7 +32,7 @@ func TestValidateDevTestVirtualNetworkName(t *testing.T) {
 		"!@£",
 	}
 	for _, v := range invalidNames {
-		_, errors := validateDevTestVirtualNetworkName()(v, "name")
+		_, errors := naming.Validate("azurerm_dev_test_virtual_network")(v, "name")
 		if len(errors) == 0 {
 			t.Fatalf("%q should be an invalid Dev Test Virtual Network Name", v)
 		}
@@ -65,7 +66,7 @@ func TestAccAzureRMDevTestVirtualNetwork_basic(t *testing.T) {
 }
 
 func TestAccAzureRMDevTestVirtualNetwork_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -44,7 +44,7 @@ func TestAccAzureRMDevTestVirtualMachine_basic(t *testing.T) {
 }
 
 func TestAccAzureRMDevTestVirtualMachine_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -58,7 +58,7 @@ func TestAccAzureRMDnsNsRecord_basic(t *testing.T) {
 }
 
 func TestAccAzureRMDnsNsRecord_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
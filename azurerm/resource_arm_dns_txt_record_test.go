@@ -37,7 +37,7 @@ func TestAccAzureRMDnsTxtRecord_basic(t *testing.T) {
 }
 
 func TestAccAzureRMDnsTxtRecord_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
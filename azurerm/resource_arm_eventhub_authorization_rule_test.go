@@ -61,7 +61,7 @@ func testAccAzureRMEventHubAuthorizationRule(t *testing.T, listen, send, manage
 }
 
 func TestAccAzureRMEventHubAuthorizationRule_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
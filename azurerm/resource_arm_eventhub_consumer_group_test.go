@@ -35,7 +35,7 @@ func TestAccAzureRMEventHubConsumerGroup_basic(t *testing.T) {
 }
 
 func TestAccAzureRMEventHubConsumerGroup_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -219,7 +219,7 @@ func TestAccAzureRMEventHub_basicOnePartition(t *testing.T) {
 }
 
 func TestAccAzureRMEventHub_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -45,7 +45,7 @@ func TestAccAzureRMFunctionApp_basic(t *testing.T) {
 }
 
 func TestAccAzureRMFunctionApp_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
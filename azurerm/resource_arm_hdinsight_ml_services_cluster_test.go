@@ -51,7 +51,7 @@ func TestAccAzureRMHDInsightMLServicesCluster_basic(t *testing.T) {
 }
 
 func TestAccAzureRMHDInsightMLServicesCluster_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
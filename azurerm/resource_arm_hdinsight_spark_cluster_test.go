@@ -48,7 +48,7 @@ func TestAccAzureRMHDInsightSparkCluster_basic(t *testing.T) {
 }
 
 func TestAccAzureRMHDInsightSparkCluster_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
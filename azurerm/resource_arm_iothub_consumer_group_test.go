@@ -37,7 +37,7 @@ func TestAccAzureRMIotHubConsumerGroup_events(t *testing.T) {
 }
 
 func TestAccAzureRMIotHubConsumerGroup_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -60,7 +60,7 @@ func TestAccAzureRMIotHub_ipFilterRules(t *testing.T) {
 }
 
 func TestAccAzureRMIotHub_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
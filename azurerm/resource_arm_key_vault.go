@@ -1,10 +1,10 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
-	"regexp"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
@@ -13,9 +13,11 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	uuid "github.com/satori/go.uuid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/set"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -40,12 +42,21 @@ func resourceArmKeyVault() *schema.Resource {
 		MigrateState:  resourceAzureRMKeyVaultMigrateState,
 		SchemaVersion: 1,
 
+		CustomizeDiff: resourceArmKeyVaultCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validateKeyVaultName,
+				ValidateFunc: naming.Validate("azurerm_key_vault"),
 			},
 
 			"location": locationSchema(),
@@ -126,6 +137,16 @@ func resourceArmKeyVault() *schema.Resource {
 				Optional: true,
 			},
 
+			"soft_delete_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"purge_protection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"network_acls": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -171,13 +192,14 @@ func resourceArmKeyVault() *schema.Resource {
 
 func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).keyVaultClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ARM KeyVault creation.")
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	if requireResourcesToBeImported && d.IsNewResource() {
+	if meta.(*ArmClient).features.requireResourcesToBeImported && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -195,6 +217,8 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	enabledForDeployment := d.Get("enabled_for_deployment").(bool)
 	enabledForDiskEncryption := d.Get("enabled_for_disk_encryption").(bool)
 	enabledForTemplateDeployment := d.Get("enabled_for_template_deployment").(bool)
+	softDeleteEnabled := d.Get("soft_delete_enabled").(bool)
+	purgeProtectionEnabled := d.Get("purge_protection_enabled").(bool)
 	tags := d.Get("tags").(map[string]interface{})
 
 	networkAclsRaw := d.Get("network_acls").([]interface{})
@@ -206,6 +230,10 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error expanding `access_policy`: %+v", policies)
 	}
 
+	if purgeProtectionEnabled && !softDeleteEnabled {
+		return fmt.Errorf("Error creating Key Vault %q (Resource Group %q): `soft_delete_enabled` must be enabled to enable `purge_protection_enabled`", name, resourceGroup)
+	}
+
 	parameters := keyvault.VaultCreateOrUpdateParameters{
 		Location: &location,
 		Properties: &keyvault.VaultProperties{
@@ -220,6 +248,14 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		Tags: expandTags(tags),
 	}
 
+	// these fields don't accept `false` as a value, so they're only sent when they're enabled
+	if softDeleteEnabled {
+		parameters.Properties.EnableSoftDelete = utils.Bool(true)
+	}
+	if purgeProtectionEnabled {
+		parameters.Properties.EnablePurgeProtection = utils.Bool(true)
+	}
+
 	// Locking this resource so we don't make modifications to it at the same time if there is a
 	// key vault access policy trying to update it as well
 	azureRMLockByName(name, keyVaultResourceName)
@@ -242,6 +278,12 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	azureRMLockMultipleByName(&virtualNetworkNames, virtualNetworkResourceName)
 	defer azureRMUnlockMultipleByName(&virtualNetworkNames, virtualNetworkResourceName)
 
+	if d.IsNewResource() {
+		if err := recoverKeyVaultIfSoftDeleted(ctx, meta.(*ArmClient), resourceGroup, name, location, parameters); err != nil {
+			return err
+		}
+	}
+
 	if _, err = client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
 		return fmt.Errorf("Error updating Key Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
@@ -282,7 +324,8 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).keyVaultClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -314,6 +357,18 @@ func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("enabled_for_template_deployment", props.EnabledForTemplateDeployment)
 		d.Set("vault_uri", props.VaultURI)
 
+		softDeleteEnabled := false
+		if props.EnableSoftDelete != nil {
+			softDeleteEnabled = *props.EnableSoftDelete
+		}
+		d.Set("soft_delete_enabled", softDeleteEnabled)
+
+		purgeProtectionEnabled := false
+		if props.EnablePurgeProtection != nil {
+			purgeProtectionEnabled = *props.EnablePurgeProtection
+		}
+		d.Set("purge_protection_enabled", purgeProtectionEnabled)
+
 		if err := d.Set("sku", flattenKeyVaultSku(props.Sku)); err != nil {
 			return fmt.Errorf("Error setting `sku` for KeyVault %q: %+v", *resp.Name, err)
 		}
@@ -334,7 +389,8 @@ func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).keyVaultClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -389,6 +445,92 @@ func resourceArmKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if !meta.(*ArmClient).features.keyVault.purgeSoftDeleteOnDestroy {
+		return nil
+	}
+
+	softDeleteEnabled, purgeProtectionEnabled := false, false
+	if props := read.Properties; props != nil {
+		softDeleteEnabled = props.EnableSoftDelete != nil && *props.EnableSoftDelete
+		purgeProtectionEnabled = props.EnablePurgeProtection != nil && *props.EnablePurgeProtection
+	}
+	if !softDeleteEnabled {
+		return nil
+	}
+	if purgeProtectionEnabled {
+		log.Printf("[DEBUG] Skipping purging Key Vault %q (Resource Group %q) since Purge Protection is enabled", name, resourceGroup)
+		return nil
+	}
+	if read.Location == nil {
+		return fmt.Errorf("Error purging Key Vault %q (Resource Group %q): `location` was nil", name, resourceGroup)
+	}
+
+	log.Printf("[DEBUG] Purging soft-deleted Key Vault %q (Resource Group %q)", name, resourceGroup)
+	future, err := client.PurgeDeleted(ctx, name, *read.Location)
+	if err != nil {
+		return fmt.Errorf("Error purging Key Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Key Vault %q (Resource Group %q) to be purged: %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// resourceArmKeyVaultCustomizeDiff rejects disabling Soft Delete or Purge Protection at plan time, since once
+// they've been enabled on a Key Vault they can't be disabled - rather than the apply failing part-way through
+func resourceArmKeyVaultCustomizeDiff(diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	if old, new := diff.GetChange("soft_delete_enabled"); old.(bool) && !new.(bool) {
+		return fmt.Errorf("once Soft Delete has been enabled on a Key Vault it can't be disabled - `soft_delete_enabled` must remain `true`")
+	}
+	if old, new := diff.GetChange("purge_protection_enabled"); old.(bool) && !new.(bool) {
+		return fmt.Errorf("once Purge Protection has been enabled on a Key Vault it can't be disabled - `purge_protection_enabled` must remain `true`")
+	}
+
+	return nil
+}
+
+// recoverKeyVaultIfSoftDeleted recovers a soft-deleted Key Vault with the same name, when the `recover_soft_deleted_key_vaults`
+// feature is enabled - otherwise an error is returned, since the Key Vault can't be created until it's been purged
+func recoverKeyVaultIfSoftDeleted(ctx context.Context, meta *ArmClient, resourceGroup, name, location string, parameters keyvault.VaultCreateOrUpdateParameters) error {
+	client := meta.keyVaultClient
+
+	deleted, err := client.GetDeleted(ctx, name, location)
+	if err != nil {
+		if utils.ResponseWasNotFound(deleted.Response) {
+			return nil
+		}
+
+		// this shouldn't block creating the Key Vault, if one exists the CreateOrUpdate will fail with a clearer error
+		log.Printf("[DEBUG] Unable to check for a soft-deleted Key Vault %q (Location %q): %+v", name, location, err)
+		return nil
+	}
+
+	if !meta.features.keyVault.recoverSoftDeletedKeyVaults {
+		return fmt.Errorf("A soft-deleted Key Vault %q exists in %q - either recover it by enabling `recover_soft_deleted_key_vaults` within the `key_vault` block of the `features` block in the Provider, or purge it and try again", name, location)
+	}
+
+	// when recovering a Key Vault the other properties are ignored, so they're applied by a subsequent CreateOrUpdate
+	log.Printf("[DEBUG] Recovering soft-deleted Key Vault %q (Resource Group %q)", name, resourceGroup)
+	recoverParameters := parameters
+	recoverProperties := *parameters.Properties
+	recoverProperties.CreateMode = keyvault.CreateModeRecover
+	recoverParameters.Properties = &recoverProperties
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, recoverParameters)
+	if err != nil {
+		return fmt.Errorf("Error recovering soft-deleted Key Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for soft-deleted Key Vault %q (Resource Group %q) to be recovered: %+v", name, resourceGroup, err)
+	}
+
 	return nil
 }
 
@@ -447,15 +589,6 @@ func flattenKeyVaultNetworkAcls(input *keyvault.NetworkRuleSet) []interface{} {
 	return []interface{}{output}
 }
 
-func validateKeyVaultName(v interface{}, k string) (warnings []string, errors []error) {
-	value := v.(string)
-	if matched := regexp.MustCompile(`^[a-zA-Z0-9-]{3,24}$`).Match([]byte(value)); !matched {
-		errors = append(errors, fmt.Errorf("%q may only contain alphanumeric characters and dashes and must be between 3-24 chars", k))
-	}
-
-	return warnings, errors
-}
-
 func keyVaultRefreshFunc(vaultUri string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] Checking to see if KeyVault %q is available..", vaultUri)
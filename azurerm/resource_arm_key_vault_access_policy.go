@@ -1,10 +1,12 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/satori/go.uuid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
@@ -13,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -26,6 +29,13 @@ func resourceArmKeyVaultAccessPolicy() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"key_vault_id": {
 				Type:          schema.TypeString,
@@ -108,9 +118,20 @@ func resourceArmKeyVaultAccessPolicy() *schema.Resource {
 
 func resourceArmKeyVaultAccessPolicyCreateOrDelete(d *schema.ResourceData, meta interface{}, action keyvault.AccessPolicyUpdateKind) error {
 	client := meta.(*ArmClient).keyVaultClient
-	ctx := meta.(*ArmClient).StopContext
 	log.Printf("[INFO] Preparing arguments for Key Vault Access Policy: %s.", action)
 
+	var ctx context.Context
+	var cancel context.CancelFunc
+	switch action {
+	case keyvault.Add:
+		ctx, cancel = timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	case keyvault.Remove:
+		ctx, cancel = timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	default:
+		ctx, cancel = timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	}
+	defer cancel()
+
 	vaultId := d.Get("key_vault_id").(string)
 	vaultName := d.Get("vault_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -135,6 +156,9 @@ func resourceArmKeyVaultAccessPolicyCreateOrDelete(d *schema.ResourceData, meta
 
 		resourceGroup = id.ResourceGroup
 
+		// the Key Vault can be in a different Subscription to the one the Provider is configured for
+		client = meta.(*ArmClient).clientForSubscription(id.SubscriptionID).keyVaultClient
+
 		vaultNameTemp, ok := id.Path["vaults"]
 		if !ok {
 			return fmt.Errorf("key_value_id does not contain `vaults`: %q", vaultId)
@@ -172,7 +196,7 @@ func resourceArmKeyVaultAccessPolicyCreateOrDelete(d *schema.ResourceData, meta
 	azureRMLockByName(vaultName, keyVaultResourceName)
 	defer azureRMUnlockByName(vaultName, keyVaultResourceName)
 
-	if requireResourcesToBeImported && d.IsNewResource() {
+	if meta.(*ArmClient).features.requireResourcesToBeImported && d.IsNewResource() {
 		props := keyVault.Properties
 		if props == nil {
 			return fmt.Errorf("Error parsing Key Vault: `properties` was nil")
@@ -275,14 +299,15 @@ func resourceArmKeyVaultAccessPolicyUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceArmKeyVaultAccessPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).keyVaultClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 
 	if err != nil {
 		return err
 	}
+	client := meta.(*ArmClient).clientForSubscription(id.SubscriptionID).keyVaultClient
 	resGroup := id.ResourceGroup
 	vaultName := id.Path["vaults"]
 	objectId := id.Path["objectId"]
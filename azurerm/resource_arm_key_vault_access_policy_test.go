@@ -68,7 +68,7 @@ func TestAccAzureRMKeyVaultAccessPolicy_basicClassic(t *testing.T) {
 }
 
 func TestAccAzureRMKeyVaultAccessPolicy_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
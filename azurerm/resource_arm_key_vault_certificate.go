@@ -10,16 +10,19 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
-//todo refactor and find a home for this wayward func
+// todo refactor and find a home for this wayward func
 func resourceArmKeyVaultChildResourceImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	client := meta.(*ArmClient).keyVaultClient
 	ctx := meta.(*ArmClient).StopContext
@@ -50,12 +53,18 @@ func resourceArmKeyVaultCertificate() *schema.Resource {
 			State: resourceArmKeyVaultChildResourceImporter,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: azure.ValidateKeyVaultChildName,
+				ValidateFunc: naming.Validate("azurerm_key_vault_certificate"),
 			},
 
 			"key_vault_id": {
@@ -336,7 +345,8 @@ func resourceArmKeyVaultCertificate() *schema.Resource {
 func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface{}) error {
 	vaultClient := meta.(*ArmClient).keyVaultClient
 	client := meta.(*ArmClient).keyVaultManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	keyVaultId := d.Get("key_vault_id").(string)
@@ -361,7 +371,7 @@ func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface
 		d.Set("key_vault_id", id)
 	}
 
-	if requireResourcesToBeImported {
+	if meta.(*ArmClient).features.requireResourcesToBeImported {
 		existing, err := client.GetCertificate(ctx, keyVaultBaseUrl, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -374,6 +384,11 @@ func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface
 		}
 	}
 
+	softDeleted := keyVaultSoftDeletedCertificate(client, keyVaultBaseUrl, name)
+	if err := softDeleted.recoverIfSoftDeleted(ctx, meta.(*ArmClient).features.keyVault, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
 	tags := d.Get("tags").(map[string]interface{})
 	policy := expandKeyVaultCertificatePolicy(d)
 
@@ -440,7 +455,8 @@ func keyVaultCertificateCreationRefreshFunc(ctx context.Context, client keyvault
 func resourceArmKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}) error {
 	keyVaultClient := meta.(*ArmClient).keyVaultClient
 	client := meta.(*ArmClient).keyVaultManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
 	if err != nil {
@@ -510,7 +526,8 @@ func resourceArmKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}
 func resourceArmKeyVaultCertificateDelete(d *schema.ResourceData, meta interface{}) error {
 	keyVaultClient := meta.(*ArmClient).keyVaultClient
 	client := meta.(*ArmClient).keyVaultManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
 	if err != nil {
@@ -544,7 +561,36 @@ func resourceArmKeyVaultCertificateDelete(d *schema.ResourceData, meta interface
 		return fmt.Errorf("Error deleting Certificate %q from Key Vault: %+v", id.Name, err)
 	}
 
-	return nil
+	recoveryLevel := ""
+	if attributes := resp.Attributes; attributes != nil {
+		recoveryLevel = string(attributes.RecoveryLevel)
+	}
+
+	softDeleted := keyVaultSoftDeletedCertificate(client, id.KeyVaultBaseUrl, id.Name)
+	return softDeleted.purgeIfSoftDeleted(ctx, meta.(*ArmClient).features.keyVault, resp.RecoveryID, recoveryLevel, d.Timeout(schema.TimeoutDelete))
+}
+
+func keyVaultSoftDeletedCertificate(client keyvault.BaseClient, keyVaultBaseUrl, name string) keyVaultSoftDeletedItem {
+	return keyVaultSoftDeletedItem{
+		itemType:        "Certificate",
+		name:            name,
+		keyVaultBaseUrl: keyVaultBaseUrl,
+		get: func(ctx context.Context) (autorest.Response, error) {
+			resp, err := client.GetCertificate(ctx, keyVaultBaseUrl, name, "")
+			return resp.Response, err
+		},
+		getDeleted: func(ctx context.Context) (autorest.Response, error) {
+			resp, err := client.GetDeletedCertificate(ctx, keyVaultBaseUrl, name)
+			return resp.Response, err
+		},
+		recover: func(ctx context.Context) error {
+			_, err := client.RecoverDeletedCertificate(ctx, keyVaultBaseUrl, name)
+			return err
+		},
+		purge: func(ctx context.Context) (autorest.Response, error) {
+			return client.PurgeDeletedCertificate(ctx, keyVaultBaseUrl, name)
+		},
+	}
 }
 
 func expandKeyVaultCertificatePolicy(d *schema.ResourceData) keyvault.CertificatePolicy {
@@ -66,7 +66,7 @@ func TestAccAzureRMKeyVaultKey_basicECClassic(t *testing.T) {
 }
 
 func TestAccAzureRMKeyVaultKey_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -1,13 +1,18 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -22,12 +27,19 @@ func resourceArmKeyVaultSecret() *schema.Resource {
 			State: resourceArmKeyVaultChildResourceImporter,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: azure.ValidateKeyVaultChildName,
+				ValidateFunc: naming.Validate("azurerm_key_vault_secret"),
 			},
 
 			"key_vault_id": {
@@ -74,7 +86,8 @@ func resourceArmKeyVaultSecret() *schema.Resource {
 func resourceArmKeyVaultSecretCreate(d *schema.ResourceData, meta interface{}) error {
 	vaultClient := meta.(*ArmClient).keyVaultClient
 	client := meta.(*ArmClient).keyVaultManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Print("[INFO] preparing arguments for AzureRM KeyVault Secret creation.")
 
@@ -101,7 +114,7 @@ func resourceArmKeyVaultSecretCreate(d *schema.ResourceData, meta interface{}) e
 		d.Set("key_vault_id", id)
 	}
 
-	if requireResourcesToBeImported {
+	if meta.(*ArmClient).features.requireResourcesToBeImported {
 		existing, err := client.GetSecret(ctx, keyVaultBaseUrl, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -114,6 +127,11 @@ func resourceArmKeyVaultSecretCreate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	softDeleted := keyVaultSoftDeletedSecret(client, keyVaultBaseUrl, name)
+	if err := softDeleted.recoverIfSoftDeleted(ctx, meta.(*ArmClient).features.keyVault, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
 	value := d.Get("value").(string)
 	contentType := d.Get("content_type").(string)
 	tags := d.Get("tags").(map[string]interface{})
@@ -145,7 +163,8 @@ func resourceArmKeyVaultSecretCreate(d *schema.ResourceData, meta interface{}) e
 func resourceArmKeyVaultSecretUpdate(d *schema.ResourceData, meta interface{}) error {
 	keyVaultClient := meta.(*ArmClient).keyVaultClient
 	client := meta.(*ArmClient).keyVaultManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Print("[INFO] preparing arguments for AzureRM KeyVault Secret update.")
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
@@ -216,7 +235,8 @@ func resourceArmKeyVaultSecretUpdate(d *schema.ResourceData, meta interface{}) e
 func resourceArmKeyVaultSecretRead(d *schema.ResourceData, meta interface{}) error {
 	keyVaultClient := meta.(*ArmClient).keyVaultClient
 	client := meta.(*ArmClient).keyVaultManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
 	if err != nil {
@@ -273,7 +293,8 @@ func resourceArmKeyVaultSecretRead(d *schema.ResourceData, meta interface{}) err
 func resourceArmKeyVaultSecretDelete(d *schema.ResourceData, meta interface{}) error {
 	keyVaultClient := meta.(*ArmClient).keyVaultClient
 	client := meta.(*ArmClient).keyVaultManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
 	if err != nil {
@@ -298,6 +319,39 @@ func resourceArmKeyVaultSecretDelete(d *schema.ResourceData, meta interface{}) e
 		return nil
 	}
 
-	_, err = client.DeleteSecret(ctx, id.KeyVaultBaseUrl, id.Name)
-	return err
+	resp, err := client.DeleteSecret(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		return err
+	}
+
+	recoveryLevel := ""
+	if attributes := resp.Attributes; attributes != nil {
+		recoveryLevel = string(attributes.RecoveryLevel)
+	}
+
+	softDeleted := keyVaultSoftDeletedSecret(client, id.KeyVaultBaseUrl, id.Name)
+	return softDeleted.purgeIfSoftDeleted(ctx, meta.(*ArmClient).features.keyVault, resp.RecoveryID, recoveryLevel, d.Timeout(schema.TimeoutDelete))
+}
+
+func keyVaultSoftDeletedSecret(client keyvault.BaseClient, keyVaultBaseUrl, name string) keyVaultSoftDeletedItem {
+	return keyVaultSoftDeletedItem{
+		itemType:        "Secret",
+		name:            name,
+		keyVaultBaseUrl: keyVaultBaseUrl,
+		get: func(ctx context.Context) (autorest.Response, error) {
+			resp, err := client.GetSecret(ctx, keyVaultBaseUrl, name, "")
+			return resp.Response, err
+		},
+		getDeleted: func(ctx context.Context) (autorest.Response, error) {
+			resp, err := client.GetDeletedSecret(ctx, keyVaultBaseUrl, name)
+			return resp.Response, err
+		},
+		recover: func(ctx context.Context) error {
+			_, err := client.RecoverDeletedSecret(ctx, keyVaultBaseUrl, name)
+			return err
+		},
+		purge: func(ctx context.Context) (autorest.Response, error) {
+			return client.PurgeDeletedSecret(ctx, keyVaultBaseUrl, name)
+		},
+	}
 }
@@ -66,7 +66,7 @@ func TestAccAzureRMKeyVaultSecret_basicClassic(t *testing.T) {
 }
 
 func TestAccAzureRMKeyVaultSecret_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -194,6 +194,39 @@ func TestAccAzureRMKeyVaultSecret_update(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMKeyVaultSecret_softDeleteRecovery(t *testing.T) {
+	resourceName := "azurerm_key_vault_secret.test"
+	rs := acctest.RandString(6)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVaultSecret_softDeleteRecovery(rs, location, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultSecretExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "rick-and-morty"),
+				),
+			},
+			{
+				// removing the Secret soft-deletes it
+				Config: testAccAzureRMKeyVaultSecret_softDeleteRecovery(rs, location, false),
+			},
+			{
+				// re-adding the Secret recovers it, rather than failing with a conflict
+				Config: testAccAzureRMKeyVaultSecret_softDeleteRecovery(rs, location, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultSecretExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "rick-and-morty"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMKeyVaultSecretDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -508,3 +541,61 @@ resource "azurerm_key_vault_secret" "test" {
 }
 `, rString, location, rString, rString)
 }
+
+func testAccAzureRMKeyVaultSecret_softDeleteRecovery(rString string, location string, includeSecret bool) string {
+	secret := ""
+	if includeSecret {
+		secret = `
+resource "azurerm_key_vault_secret" "test" {
+  name         = "secret-` + rString + `"
+  value        = "rick-and-morty"
+  key_vault_id = "${azurerm_key_vault.test.id}"
+}
+`
+	}
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    key_vault {
+      purge_soft_delete_on_destroy    = true
+      recover_soft_deleted_key_vaults = true
+    }
+  }
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%s"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv-%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+  soft_delete_enabled = true
+
+  sku {
+    name = "premium"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    secret_permissions = [
+      "get",
+      "delete",
+      "purge",
+      "recover",
+      "set",
+    ]
+  }
+}
+
+%s
+`, rString, location, rString, secret)
+}
@@ -2,11 +2,13 @@ package azurerm
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -47,7 +49,7 @@ func TestAccAzureRMKeyVault_name(t *testing.T) {
 		},
 		{
 			Input:       "20202020",
-			ExpectError: false,
+			ExpectError: true,
 		},
 		{
 			Input:       "ABC123!@£",
@@ -60,7 +62,7 @@ func TestAccAzureRMKeyVault_name(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		_, errors := validateKeyVaultName(tc.Input, "")
+		_, errors := naming.Validate("azurerm_key_vault")(tc.Input, "")
 
 		hasError := len(errors) > 0
 
@@ -97,7 +99,7 @@ func TestAccAzureRMKeyVault_basic(t *testing.T) {
 }
 
 func TestAccAzureRMKeyVault_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -312,6 +314,70 @@ func TestAccAzureRMKeyVault_justCert(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMKeyVault_softDelete(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	resourceName := "azurerm_key_vault.test"
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVault_softDelete(ri, location, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "soft_delete_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "purge_protection_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccAzureRMKeyVault_softDelete(ri, location, false),
+				ExpectError: regexp.MustCompile("once Soft Delete has been enabled it can't be disabled"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVault_softDeleteRecovery(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	resourceName := "azurerm_key_vault.test"
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVault_softDeleteRecovery(ri, location, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "soft_delete_enabled", "true"),
+				),
+			},
+			{
+				// removing the Key Vault soft-deletes it, since it isn't purged on destroy
+				Config: testAccAzureRMKeyVault_softDeleteRecovery(ri, location, false),
+			},
+			{
+				// re-adding the Key Vault recovers it, rather than failing since the name's in use
+				Config: testAccAzureRMKeyVault_softDeleteRecovery(ri, location, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "soft_delete_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMKeyVaultDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ArmClient).keyVaultClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -819,3 +885,74 @@ func testAccAzureRMKeyVault_generateAccessPolicyConfigs(accountNum int) string {
   }
 `, accountNum)
 }
+
+func testAccAzureRMKeyVault_softDelete(rInt int, location string, enabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    key_vault {
+      purge_soft_delete_on_destroy = true
+    }
+  }
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "vault%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+  soft_delete_enabled = %t
+
+  sku {
+    name = "premium"
+  }
+}
+`, rInt, location, rInt, enabled)
+}
+
+func testAccAzureRMKeyVault_softDeleteRecovery(rInt int, location string, includeKeyVault bool) string {
+	keyVault := ""
+	if includeKeyVault {
+		keyVault = fmt.Sprintf(`
+resource "azurerm_key_vault" "test" {
+  name                = "vault%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+  soft_delete_enabled = true
+
+  sku {
+    name = "premium"
+  }
+}
+`, rInt)
+	}
+
+	// the Key Vault is only purged when it's destroyed at the end of the test, rather than when it's removed
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    key_vault {
+      purge_soft_delete_on_destroy    = %t
+      recover_soft_deleted_key_vaults = true
+    }
+  }
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+%s
+`, includeKeyVault, rInt, location, keyVault)
+}
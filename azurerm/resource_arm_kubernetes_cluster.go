@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2019-02-01/containerservice"
 	"github.com/hashicorp/terraform/helper/hashcode"
@@ -12,12 +13,16 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/kubernetes"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var kubernetesClusterResourceName = "azurerm_kubernetes_cluster"
+
 func resourceArmKubernetesCluster() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmKubernetesClusterCreateUpdate,
@@ -29,6 +34,10 @@ func resourceArmKubernetesCluster() *schema.Resource {
 		},
 
 		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			if err := kubernetesClusterVersionCustomizeDiff(diff, v); err != nil {
+				return err
+			}
+
 			if v, exists := diff.GetOk("network_profile"); exists {
 				rawProfiles := v.([]interface{})
 				if len(rawProfiles) == 0 {
@@ -63,12 +72,19 @@ func resourceArmKubernetesCluster() *schema.Resource {
 			return nil
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validate.NoEmptyStrings,
+				ValidateFunc: naming.Validate("azurerm_kubernetes_cluster"),
 			},
 
 			"location": locationSchema(),
@@ -538,7 +554,8 @@ func resourceArmKubernetesCluster() *schema.Resource {
 
 func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).kubernetesClustersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	tenantId := meta.(*ArmClient).tenantId
 
 	log.Printf("[INFO] preparing arguments for Managed Kubernetes Cluster create/update.")
@@ -546,7 +563,7 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 	resGroup := d.Get("resource_group_name").(string)
 	name := d.Get("name").(string)
 
-	if requireResourcesToBeImported && d.IsNewResource() {
+	if meta.(*ArmClient).features.requireResourcesToBeImported && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -583,6 +600,35 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 
+	// Managed Kubernetes Clusters only support a single operation at once
+	azureRMLockByName(name, kubernetesClusterResourceName)
+	defer azureRMUnlockByName(name, kubernetesClusterResourceName)
+
+	// the Control Plane is upgraded before the Node Pools, rather than upgrading everything in a single request
+	if !d.IsNewResource() && d.HasChange("kubernetes_version") && kubernetesVersion != "" {
+		nodePoolNames := make([]string, 0)
+		for _, profile := range agentProfiles {
+			nodePoolNames = append(nodePoolNames, *profile.Name)
+		}
+
+		if err := upgradeKubernetesCluster(ctx, meta, resGroup, name, kubernetesVersion, nodePoolNames); err != nil {
+			return err
+		}
+	}
+
+	// Node Pools managed using the `azurerm_kubernetes_cluster_node_pool` resource need to be sent when updating
+	// the Cluster, otherwise they'd be removed
+	if !d.IsNewResource() {
+		existing, err := client.Get(ctx, resGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if props := existing.ManagedClusterProperties; props != nil {
+			agentProfiles = append(agentProfiles, kubernetesClusterAdditionalAgentPoolProfiles(props.AgentPoolProfiles, agentProfiles)...)
+		}
+	}
+
 	rbacRaw := d.Get("role_based_access_control").([]interface{})
 	rbacEnabled, azureADProfile := expandKubernetesClusterRoleBasedAccessControl(rbacRaw, tenantId)
 
@@ -632,7 +678,8 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 
 func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).kubernetesClustersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -679,7 +726,11 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("Error setting `addon_profile`: %+v", err)
 		}
 
-		agentPoolProfiles := flattenKubernetesClusterAgentPoolProfiles(props.AgentPoolProfiles, resp.Fqdn)
+		// only the Node Pool defined in the `agent_pool_profile` block is set, since any others are managed
+		// using the `azurerm_kubernetes_cluster_node_pool` resource
+		agentPoolProfileName := d.Get("agent_pool_profile.0.name").(string)
+		defaultAgentPoolProfiles := kubernetesClusterDefaultAgentPoolProfiles(props.AgentPoolProfiles, agentPoolProfileName)
+		agentPoolProfiles := flattenKubernetesClusterAgentPoolProfiles(defaultAgentPoolProfiles, resp.Fqdn)
 		if err := d.Set("agent_pool_profile", agentPoolProfiles); err != nil {
 			return fmt.Errorf("Error setting `agent_pool_profile`: %+v", err)
 		}
@@ -735,7 +786,8 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmKubernetesClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).kubernetesClustersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -935,6 +987,51 @@ func expandKubernetesClusterAgentPoolProfiles(d *schema.ResourceData) []containe
 	return []containerservice.ManagedClusterAgentPoolProfile{profile}
 }
 
+// kubernetesClusterDefaultAgentPoolProfiles returns the Agent Pool Profile with the specified name - or the first
+// Agent Pool Profile when no name is specified (for example when importing)
+func kubernetesClusterDefaultAgentPoolProfiles(profiles *[]containerservice.ManagedClusterAgentPoolProfile, name string) *[]containerservice.ManagedClusterAgentPoolProfile {
+	if profiles == nil {
+		return nil
+	}
+
+	for _, profile := range *profiles {
+		if name == "" || (profile.Name != nil && strings.EqualFold(*profile.Name, name)) {
+			return &[]containerservice.ManagedClusterAgentPoolProfile{profile}
+		}
+	}
+
+	return &[]containerservice.ManagedClusterAgentPoolProfile{}
+}
+
+// kubernetesClusterAdditionalAgentPoolProfiles returns the existing Agent Pool Profiles which aren't defined in the
+// `agent_pool_profile` block, which are managed using the `azurerm_kubernetes_cluster_node_pool` resource
+func kubernetesClusterAdditionalAgentPoolProfiles(existing *[]containerservice.ManagedClusterAgentPoolProfile, defined []containerservice.ManagedClusterAgentPoolProfile) []containerservice.ManagedClusterAgentPoolProfile {
+	additional := make([]containerservice.ManagedClusterAgentPoolProfile, 0)
+	if existing == nil {
+		return additional
+	}
+
+	for _, profile := range *existing {
+		if profile.Name == nil {
+			continue
+		}
+
+		isDefined := false
+		for _, v := range defined {
+			if v.Name != nil && strings.EqualFold(*v.Name, *profile.Name) {
+				isDefined = true
+				break
+			}
+		}
+
+		if !isDefined {
+			additional = append(additional, profile)
+		}
+	}
+
+	return additional
+}
+
 func flattenKubernetesClusterAgentPoolProfiles(profiles *[]containerservice.ManagedClusterAgentPoolProfile, fqdn *string) []interface{} {
 	if profiles == nil {
 		return []interface{}{}
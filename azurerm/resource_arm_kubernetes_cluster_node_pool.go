@@ -0,0 +1,413 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2019-02-01/containerservice"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmKubernetesClusterNodePool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKubernetesClusterNodePoolCreate,
+		Read:   resourceArmKubernetesClusterNodePoolRead,
+		Update: resourceArmKubernetesClusterNodePoolUpdate,
+		Delete: resourceArmKubernetesClusterNodePoolDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: naming.Validate("azurerm_kubernetes_cluster_node_pool"),
+			},
+
+			"kubernetes_cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidateKubernetesClusterID,
+			},
+
+			"vm_size": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     validate.NoEmptyStrings,
+			},
+
+			"node_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			"enable_auto_scaling": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"min_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			"max_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			"availability_zones": azure.SchemaZones(),
+
+			"max_pods": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"orchestrator_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"os_disk_size_gb": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"os_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(containerservice.Linux),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(containerservice.Linux),
+					string(containerservice.Windows),
+				}, true),
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"vnet_subnet_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+	}
+}
+
+func resourceArmKubernetesClusterNodePoolCreate(d *schema.ResourceData, meta interface{}) error {
+	clustersClient := meta.(*ArmClient).kubernetesClustersClient
+	client := meta.(*ArmClient).kubernetesClusterNodePoolsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	clusterId, err := resourceid.ParseKubernetesClusterID(d.Get("kubernetes_cluster_id").(string))
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Retrieving Managed Kubernetes Cluster %q (Resource Group %q)..", clusterId.Name, clusterId.ResourceGroup)
+	cluster, err := clustersClient.Get(ctx, clusterId.ResourceGroup, clusterId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(cluster.Response) {
+			return fmt.Errorf("Managed Kubernetes Cluster %q was not found in Resource Group %q!", clusterId.Name, clusterId.ResourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Managed Kubernetes Cluster %q (Resource Group %q): %+v", clusterId.Name, clusterId.ResourceGroup, err)
+	}
+
+	// multiple Node Pools are only supported when the Cluster uses Virtual Machine Scale Sets
+	if props := cluster.ManagedClusterProperties; props != nil && props.AgentPoolProfiles != nil {
+		for _, profile := range *props.AgentPoolProfiles {
+			if profile.Type != containerservice.VirtualMachineScaleSets {
+				return fmt.Errorf("Node Pools can only be added to Managed Kubernetes Clusters where the `type` of the `agent_pool_profile` is `%s`", string(containerservice.VirtualMachineScaleSets))
+			}
+		}
+	}
+
+	if meta.(*ArmClient).features.requireResourcesToBeImported {
+		existing, err := client.Get(ctx, clusterId.ResourceGroup, clusterId.Name, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %s", name, clusterId.Name, clusterId.ResourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_kubernetes_cluster_node_pool", *existing.ID)
+		}
+	}
+
+	profile, err := expandKubernetesClusterNodePoolProfile(d)
+	if err != nil {
+		return err
+	}
+
+	parameters := containerservice.AgentPool{
+		Name:                                     utils.String(name),
+		ManagedClusterAgentPoolProfileProperties: profile,
+	}
+
+	// Managed Kubernetes Clusters only support a single operation at once
+	azureRMLockByName(clusterId.Name, kubernetesClusterResourceName)
+	defer azureRMUnlockByName(clusterId.Name, kubernetesClusterResourceName)
+
+	future, err := client.CreateOrUpdate(ctx, clusterId.ResourceGroup, clusterId.Name, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", name, clusterId.Name, clusterId.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", name, clusterId.Name, clusterId.ResourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, clusterId.ResourceGroup, clusterId.Name, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", name, clusterId.Name, clusterId.ResourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Node Pool %q (Kubernetes Cluster %q / Resource Group %q)", name, clusterId.Name, clusterId.ResourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmKubernetesClusterNodePoolRead(d, meta)
+}
+
+func resourceArmKubernetesClusterNodePoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).kubernetesClusterNodePoolsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseKubernetesClusterNodePoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	profile, err := expandKubernetesClusterNodePoolProfile(d)
+	if err != nil {
+		return err
+	}
+
+	parameters := containerservice.AgentPool{
+		Name:                                     utils.String(id.Name),
+		ManagedClusterAgentPoolProfileProperties: profile,
+	}
+
+	azureRMLockByName(id.ManagedClusterName, kubernetesClusterResourceName)
+	defer azureRMUnlockByName(id.ManagedClusterName, kubernetesClusterResourceName)
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedClusterName, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error updating Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", id.Name, id.ManagedClusterName, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", id.Name, id.ManagedClusterName, id.ResourceGroup, err)
+	}
+
+	return resourceArmKubernetesClusterNodePoolRead(d, meta)
+}
+
+func resourceArmKubernetesClusterNodePoolRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).kubernetesClusterNodePoolsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseKubernetesClusterNodePoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ManagedClusterName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Node Pool %q was not found in Kubernetes Cluster %q / Resource Group %q - removing from state!", id.Name, id.ManagedClusterName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", id.Name, id.ManagedClusterName, id.ResourceGroup, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("kubernetes_cluster_id", resourceid.NewKubernetesClusterID(id.SubscriptionId, id.ResourceGroup, id.ManagedClusterName).ID())
+
+	if props := resp.ManagedClusterAgentPoolProfileProperties; props != nil {
+		d.Set("vm_size", string(props.VMSize))
+		d.Set("os_type", string(props.OsType))
+		d.Set("orchestrator_version", props.OrchestratorVersion)
+		d.Set("vnet_subnet_id", props.VnetSubnetID)
+
+		enableAutoScaling := false
+		if props.EnableAutoScaling != nil {
+			enableAutoScaling = *props.EnableAutoScaling
+		}
+		d.Set("enable_auto_scaling", enableAutoScaling)
+
+		nodeCount := 0
+		if props.Count != nil {
+			nodeCount = int(*props.Count)
+		}
+		d.Set("node_count", nodeCount)
+
+		minCount := 0
+		if props.MinCount != nil {
+			minCount = int(*props.MinCount)
+		}
+		d.Set("min_count", minCount)
+
+		maxCount := 0
+		if props.MaxCount != nil {
+			maxCount = int(*props.MaxCount)
+		}
+		d.Set("max_count", maxCount)
+
+		maxPods := 0
+		if props.MaxPods != nil {
+			maxPods = int(*props.MaxPods)
+		}
+		d.Set("max_pods", maxPods)
+
+		osDiskSizeGB := 0
+		if props.OsDiskSizeGB != nil {
+			osDiskSizeGB = int(*props.OsDiskSizeGB)
+		}
+		d.Set("os_disk_size_gb", osDiskSizeGB)
+
+		if err := d.Set("availability_zones", utils.FlattenStringArray(props.AvailabilityZones)); err != nil {
+			return fmt.Errorf("Error setting `availability_zones`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmKubernetesClusterNodePoolDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).kubernetesClusterNodePoolsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseKubernetesClusterNodePoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(id.ManagedClusterName, kubernetesClusterResourceName)
+	defer azureRMUnlockByName(id.ManagedClusterName, kubernetesClusterResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ManagedClusterName, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", id.Name, id.ManagedClusterName, id.ResourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the deletion of Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", id.Name, id.ManagedClusterName, id.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandKubernetesClusterNodePoolProfile(d *schema.ResourceData) (*containerservice.ManagedClusterAgentPoolProfileProperties, error) {
+	enableAutoScaling := d.Get("enable_auto_scaling").(bool)
+	minCount := d.Get("min_count").(int)
+	maxCount := d.Get("max_count").(int)
+	nodeCount := d.Get("node_count").(int)
+
+	profile := containerservice.ManagedClusterAgentPoolProfileProperties{
+		Type:              containerservice.VirtualMachineScaleSets,
+		VMSize:            containerservice.VMSizeTypes(d.Get("vm_size").(string)),
+		OsType:            containerservice.OSType(d.Get("os_type").(string)),
+		EnableAutoScaling: utils.Bool(enableAutoScaling),
+		AvailabilityZones: azure.ExpandZones(d.Get("availability_zones").([]interface{})),
+	}
+
+	if enableAutoScaling {
+		if minCount == 0 || maxCount == 0 {
+			return nil, fmt.Errorf("`min_count` and `max_count` must be set when `enable_auto_scaling` is enabled")
+		}
+		if minCount > maxCount {
+			return nil, fmt.Errorf("`max_count` must be greater than or equal to `min_count`")
+		}
+
+		// the number of nodes is managed by the Cluster Autoscaler once it's been created
+		if nodeCount == 0 {
+			nodeCount = minCount
+		}
+		if nodeCount < minCount || nodeCount > maxCount {
+			return nil, fmt.Errorf("`node_count` must be between `min_count` (%d) and `max_count` (%d) when `enable_auto_scaling` is enabled", minCount, maxCount)
+		}
+
+		profile.MinCount = utils.Int32(int32(minCount))
+		profile.MaxCount = utils.Int32(int32(maxCount))
+	} else {
+		if minCount > 0 || maxCount > 0 {
+			return nil, fmt.Errorf("`min_count` and `max_count` can only be set when `enable_auto_scaling` is enabled")
+		}
+
+		if nodeCount == 0 {
+			nodeCount = 1
+		}
+	}
+	profile.Count = utils.Int32(int32(nodeCount))
+
+	if v := d.Get("max_pods").(int); v > 0 {
+		profile.MaxPods = utils.Int32(int32(v))
+	}
+
+	if v := d.Get("os_disk_size_gb").(int); v > 0 {
+		profile.OsDiskSizeGB = utils.Int32(int32(v))
+	}
+
+	if v := d.Get("orchestrator_version").(string); v != "" {
+		profile.OrchestratorVersion = utils.String(v)
+	}
+
+	if v := d.Get("vnet_subnet_id").(string); v != "" {
+		profile.VnetSubnetID = utils.String(v)
+	}
+
+	return &profile, nil
+}
@@ -5,10 +5,11 @@ import (
 	"os"
 	"testing"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-02-01/network"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
@@ -60,7 +61,7 @@ func TestResourceAzureRMLoadBalancerRuleNameLabel_validation(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		_, errors := validateArmLoadBalancerRuleName(tc.Value, "azurerm_lb_rule")
+		_, errors := naming.Validate("azurerm_lb_rule")(tc.Value, "azurerm_lb_rule")
 
 		if len(errors) != tc.ErrCount {
 			t.Fatalf("Expected the Azure RM Load Balancer Rule Name Label to trigger a validation error")
@@ -104,7 +105,7 @@ func TestAccAzureRMLoadBalancerRule_basic(t *testing.T) {
 }
 
 func TestAccAzureRMLoadBalancerRule_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -38,7 +38,7 @@ func TestAccAzureRMLogAnalyticsLinkedService_basic(t *testing.T) {
 }
 
 func TestAccAzureRMLogAnalyticsLinkedService_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -34,7 +34,7 @@ func TestAccAzureRMLogicAppActionCustom_basic(t *testing.T) {
 }
 
 func TestAccAzureRMLogicAppActionCustom_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -33,7 +33,7 @@ func TestAccAzureRMLogicAppTriggerCustom_basic(t *testing.T) {
 }
 
 func TestAccAzureRMLogicAppTriggerCustom_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
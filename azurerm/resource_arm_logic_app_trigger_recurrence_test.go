@@ -35,7 +35,7 @@ func TestAccAzureRMLogicAppTriggerRecurrence_month(t *testing.T) {
 }
 
 func TestAccAzureRMLogicAppTriggerRecurrence_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -37,7 +37,7 @@ func TestAccAzureRMLogicAppWorkflow_empty(t *testing.T) {
 }
 
 func TestAccAzureRMLogicAppWorkflow_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -38,7 +38,7 @@ func TestAccAzureRMMariaDbDatabase_basic(t *testing.T) {
 }
 
 func TestAccAzureRMMariaDbDatabase_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -40,7 +40,7 @@ func TestAccAzureRMMonitorActionGroup_basic(t *testing.T) {
 }
 
 func TestAccAzureRMMonitorActionGroup_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
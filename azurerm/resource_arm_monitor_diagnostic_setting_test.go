@@ -43,7 +43,7 @@ func TestAccAzureRMMonitorDiagnosticSetting_eventhub(t *testing.T) {
 }
 
 func TestAccAzureRMMonitorDiagnosticSetting_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -38,7 +38,7 @@ func TestAccAzureRMMsSqlElasticPool_basic_DTU(t *testing.T) {
 }
 
 func TestAccAzureRMMsSqlElasticPool_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
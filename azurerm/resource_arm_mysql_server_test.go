@@ -38,7 +38,7 @@ func TestAccAzureRMMySQLServer_basicFiveSix(t *testing.T) {
 }
 
 func TestAccAzureRMMySQLServer_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -66,7 +66,7 @@ func TestAccAzureRMMySQLServer_requiresImport(t *testing.T) {
 }
 
 func TestAccAzureRMMySQLServer_basicFiveSeven(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
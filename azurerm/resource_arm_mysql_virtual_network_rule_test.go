@@ -32,7 +32,7 @@ func TestAccAzureRMMySqlVirtualNetworkRule_basic(t *testing.T) {
 }
 
 func TestAccAzureRMMySqlVirtualNetworkRule_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
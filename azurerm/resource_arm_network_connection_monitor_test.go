@@ -42,7 +42,7 @@ func testAccAzureRMNetworkConnectionMonitor_addressBasic(t *testing.T) {
 }
 
 func testAccAzureRMNetworkConnectionMonitor_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -66,7 +66,7 @@ func testAccAzureRMNetworkDDoSProtectionPlan_basic(t *testing.T) {
 }
 
 func testAccAzureRMNetworkDDoSProtectionPlan_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
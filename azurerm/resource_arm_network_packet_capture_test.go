@@ -38,7 +38,7 @@ func testAccAzureRMNetworkPacketCapture_localDisk(t *testing.T) {
 }
 
 func testAccAzureRMNetworkPacketCapture_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
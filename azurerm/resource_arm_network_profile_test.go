@@ -37,7 +37,7 @@ func TestAccAzureRMNetworkProfile_basic(t *testing.T) {
 }
 
 func TestAccAzureRMNetworkProfile_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -103,7 +103,7 @@ func testAccAzureRMNetworkWatcher_basic(t *testing.T) {
 }
 
 func testAccAzureRMNetworkWatcher_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
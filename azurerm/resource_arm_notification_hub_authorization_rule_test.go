@@ -40,7 +40,7 @@ func TestAccAzureRMNotificationHubAuthorizationRule_listen(t *testing.T) {
 }
 
 func TestAccAzureRMNotificationHubAuthorizationRule_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
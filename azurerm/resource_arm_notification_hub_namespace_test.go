@@ -35,7 +35,7 @@ func TestAccAzureRMNotificationHubNamespace_free(t *testing.T) {
 }
 
 func TestAccAzureRMNotificationHubNamespace_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -38,7 +38,7 @@ func testAccAzureRMPacketCapture_localDisk(t *testing.T) {
 }
 
 func testAccAzureRMPacketCapture_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
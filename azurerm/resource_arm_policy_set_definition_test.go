@@ -39,7 +39,7 @@ func TestAccAzureRMPolicySetDefinition_builtIn(t *testing.T) {
 }
 
 func TestAccAzureRMPolicySetDefinition_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
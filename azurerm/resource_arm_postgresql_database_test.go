@@ -32,7 +32,7 @@ func TestAccAzureRMPostgreSQLDatabase_basic(t *testing.T) {
 }
 
 func TestAccAzureRMPostgreSQLDatabase_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -0,0 +1,222 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmPrivateDnsARecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPrivateDnsARecordCreateUpdate,
+		Read:   resourceArmPrivateDnsARecordRead,
+		Update: resourceArmPrivateDnsARecordCreateUpdate,
+		Delete: resourceArmPrivateDnsARecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: naming.Validate("azurerm_private_dns_a_record"),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"zone_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"records": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"ttl": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 2147483647),
+			},
+
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmPrivateDnsARecordCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).privateDnsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	zoneName := d.Get("zone_name").(string)
+
+	if meta.(*ArmClient).features.requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resGroup, zoneName, privatedns.A, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Private DNS A Record %q (Zone %q / Resource Group %q): %s", name, zoneName, resGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_private_dns_a_record", *existing.ID)
+		}
+	}
+
+	ttl := int64(d.Get("ttl").(int))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := privatedns.RecordSet{
+		Name: &name,
+		RecordSetProperties: &privatedns.RecordSetProperties{
+			Metadata: expandTags(tags),
+			TTL:      &ttl,
+			ARecords: expandAzureRmPrivateDnsARecords(d),
+		},
+	}
+
+	eTag := ""
+	ifNoneMatch := "" // set to empty to allow updates to records after creation
+	if _, err := client.CreateOrUpdate(ctx, resGroup, zoneName, privatedns.A, name, parameters, eTag, ifNoneMatch); err != nil {
+		return fmt.Errorf("Error creating/updating Private DNS A Record %q (Zone %q / Resource Group %q): %s", name, zoneName, resGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resGroup, zoneName, privatedns.A, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Private DNS A Record %q (Zone %q / Resource Group %q): %s", name, zoneName, resGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Private DNS A Record %q (Zone %q / Resource Group %q) ID", name, zoneName, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmPrivateDnsARecordRead(d, meta)
+}
+
+func resourceArmPrivateDnsARecordRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).privateDnsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParsePrivateDnsARecordID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	name := id.Name
+	zoneName := id.PrivateDnsZoneName
+
+	resp, err := client.Get(ctx, resGroup, zoneName, privatedns.A, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Private DNS A Record %q (Zone %q / Resource Group %q): %+v", name, zoneName, resGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("zone_name", zoneName)
+
+	if props := resp.RecordSetProperties; props != nil {
+		d.Set("ttl", props.TTL)
+		d.Set("fqdn", props.Fqdn)
+
+		if err := d.Set("records", flattenAzureRmPrivateDnsARecords(props.ARecords)); err != nil {
+			return fmt.Errorf("Error setting `records`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Metadata)
+
+	return nil
+}
+
+func resourceArmPrivateDnsARecordDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).privateDnsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParsePrivateDnsARecordID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	name := id.Name
+	zoneName := id.PrivateDnsZoneName
+
+	resp, err := client.Delete(ctx, resGroup, zoneName, privatedns.A, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Private DNS A Record %q (Zone %q / Resource Group %q): %+v", name, zoneName, resGroup, err)
+	}
+
+	return nil
+}
+
+func flattenAzureRmPrivateDnsARecords(records *[]privatedns.ARecord) []string {
+	results := make([]string, 0)
+	if records == nil {
+		return results
+	}
+
+	for _, record := range *records {
+		if record.Ipv4Address == nil {
+			continue
+		}
+
+		results = append(results, *record.Ipv4Address)
+	}
+
+	return results
+}
+
+func expandAzureRmPrivateDnsARecords(d *schema.ResourceData) *[]privatedns.ARecord {
+	recordStrings := d.Get("records").(*schema.Set).List()
+	records := make([]privatedns.ARecord, len(recordStrings))
+
+	for i, v := range recordStrings {
+		value := v.(string)
+		records[i] = privatedns.ARecord{
+			Ipv4Address: &value,
+		}
+	}
+
+	return &records
+}
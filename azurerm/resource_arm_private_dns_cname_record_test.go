@@ -0,0 +1,299 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMPrivateDnsCNameRecord_basic(t *testing.T) {
+	resourceName := "azurerm_private_dns_cname_record.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMPrivateDnsCNameRecord_basic(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsCNameRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsCNameRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record", "contoso.com"),
+					resource.TestCheckResourceAttrSet(resourceName, "fqdn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateDnsCNameRecord_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImportedFromEnvironment() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_private_dns_cname_record.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsCNameRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateDnsCNameRecord_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsCNameRecordExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMPrivateDnsCNameRecord_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_private_dns_cname_record"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateDnsCNameRecord_updateRecords(t *testing.T) {
+	resourceName := "azurerm_private_dns_cname_record.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsCNameRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateDnsCNameRecord_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsCNameRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record", "contoso.com"),
+				),
+			},
+			{
+				Config: testAccAzureRMPrivateDnsCNameRecord_updateRecords(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsCNameRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record", "contoso.co.uk"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateDnsCNameRecord_withTags(t *testing.T) {
+	resourceName := "azurerm_private_dns_cname_record.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsCNameRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateDnsCNameRecord_withTags(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsCNameRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+				),
+			},
+			{
+				Config: testAccAzureRMPrivateDnsCNameRecord_withTagsUpdate(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsCNameRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMPrivateDnsCNameRecordExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		zoneName := rs.Primary.Attributes["zone_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Private DNS CNAME Record: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).privateDnsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, resourceGroup, zoneName, privatedns.CNAME, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Private DNS CNAME Record %q (Zone %q / Resource Group %q) does not exist", name, zoneName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on privateDnsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMPrivateDnsCNameRecordDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).privateDnsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_private_dns_cname_record" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		zoneName := rs.Primary.Attributes["zone_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, zoneName, privatedns.CNAME, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Private DNS CNAME Record still exists:\n%#v", resp.RecordSetProperties)
+	}
+
+	return nil
+}
+
+func testAccAzureRMPrivateDnsCNameRecord_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%[1]d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_private_dns_cname_record" "test" {
+  name                = "myrecord%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_private_dns_zone.test.name}"
+  ttl                 = 300
+  record              = "contoso.com"
+}
+`, rInt, location)
+}
+
+func testAccAzureRMPrivateDnsCNameRecord_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMPrivateDnsCNameRecord_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_cname_record" "import" {
+  name                = "${azurerm_private_dns_cname_record.test.name}"
+  resource_group_name = "${azurerm_private_dns_cname_record.test.resource_group_name}"
+  zone_name           = "${azurerm_private_dns_cname_record.test.zone_name}"
+  ttl                 = 300
+  record              = "contoso.com"
+}
+`, template)
+}
+
+func testAccAzureRMPrivateDnsCNameRecord_updateRecords(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%[1]d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_private_dns_cname_record" "test" {
+  name                = "myrecord%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_private_dns_zone.test.name}"
+  ttl                 = 300
+  record              = "contoso.co.uk"
+}
+`, rInt, location)
+}
+
+func testAccAzureRMPrivateDnsCNameRecord_withTags(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%[1]d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_private_dns_cname_record" "test" {
+  name                = "myrecord%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_private_dns_zone.test.name}"
+  ttl                 = 300
+  record              = "contoso.com"
+
+  tags = {
+    environment = "Production"
+    cost_center = "MSFT"
+  }
+}
+`, rInt, location)
+}
+
+func testAccAzureRMPrivateDnsCNameRecord_withTagsUpdate(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%[1]d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_private_dns_cname_record" "test" {
+  name                = "myrecord%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_private_dns_zone.test.name}"
+  ttl                 = 300
+  record              = "contoso.com"
+
+  tags = {
+    environment = "staging"
+  }
+}
+`, rInt, location)
+}
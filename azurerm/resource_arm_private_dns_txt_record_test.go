@@ -0,0 +1,387 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestSplitPrivateDnsTxtRecordValue(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected []int
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: []int{0},
+		},
+		{
+			Name:     "Short",
+			Input:    "hello",
+			Expected: []int{5},
+		},
+		{
+			Name:     "Maximum Length",
+			Input:    strings.Repeat("a", 255),
+			Expected: []int{255},
+		},
+		{
+			Name:     "Split",
+			Input:    strings.Repeat("a", 600),
+			Expected: []int{255, 255, 90},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := splitPrivateDnsTxtRecordValue(v.Input)
+		if len(actual) != len(v.Expected) {
+			t.Fatalf("Expected %d values but got %d", len(v.Expected), len(actual))
+		}
+
+		for i, length := range v.Expected {
+			if len(actual[i]) != length {
+				t.Fatalf("Expected value %d to be %d characters but got %d", i, length, len(actual[i]))
+			}
+		}
+
+		if joined := strings.Join(actual, ""); joined != v.Input {
+			t.Fatalf("Expected the values to join to the input but got %q", joined)
+		}
+	}
+}
+
+func TestAccAzureRMPrivateDnsTxtRecord_basic(t *testing.T) {
+	resourceName := "azurerm_private_dns_txt_record.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMPrivateDnsTxtRecord_basic(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsTxtRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsTxtRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "fqdn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateDnsTxtRecord_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImportedFromEnvironment() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_private_dns_txt_record.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsTxtRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateDnsTxtRecord_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsTxtRecordExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMPrivateDnsTxtRecord_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_private_dns_txt_record"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateDnsTxtRecord_updateRecords(t *testing.T) {
+	resourceName := "azurerm_private_dns_txt_record.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsTxtRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateDnsTxtRecord_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsTxtRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+				),
+			},
+			{
+				Config: testAccAzureRMPrivateDnsTxtRecord_updateRecords(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsTxtRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateDnsTxtRecord_withTags(t *testing.T) {
+	resourceName := "azurerm_private_dns_txt_record.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsTxtRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateDnsTxtRecord_withTags(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsTxtRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+				),
+			},
+			{
+				Config: testAccAzureRMPrivateDnsTxtRecord_withTagsUpdate(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsTxtRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMPrivateDnsTxtRecordExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		zoneName := rs.Primary.Attributes["zone_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Private DNS TXT Record: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).privateDnsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, resourceGroup, zoneName, privatedns.TXT, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Private DNS TXT Record %q (Zone %q / Resource Group %q) does not exist", name, zoneName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on privateDnsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMPrivateDnsTxtRecordDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).privateDnsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_private_dns_txt_record" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		zoneName := rs.Primary.Attributes["zone_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, zoneName, privatedns.TXT, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Private DNS TXT Record still exists:\n%#v", resp.RecordSetProperties)
+	}
+
+	return nil
+}
+
+func testAccAzureRMPrivateDnsTxtRecord_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%[1]d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_private_dns_txt_record" "test" {
+  name                = "myrecord%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_private_dns_zone.test.name}"
+  ttl                 = 300
+
+  record {
+    value = "Quick brown fox"
+  }
+
+  record {
+    value = "A long text value which is more than 255 characters long is split into multiple strings by the API, so this value checks that it round trips correctly - since the value needs to be long enough to be split up, this is padded out with some additional text which is here to make this more than 255 characters"
+  }
+}
+`, rInt, location)
+}
+
+func testAccAzureRMPrivateDnsTxtRecord_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMPrivateDnsTxtRecord_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_txt_record" "import" {
+  name                = "${azurerm_private_dns_txt_record.test.name}"
+  resource_group_name = "${azurerm_private_dns_txt_record.test.resource_group_name}"
+  zone_name           = "${azurerm_private_dns_txt_record.test.zone_name}"
+  ttl                 = 300
+
+  record {
+    value = "Quick brown fox"
+  }
+
+  record {
+    value = "A long text value which is more than 255 characters long is split into multiple strings by the API, so this value checks that it round trips correctly - since the value needs to be long enough to be split up, this is padded out with some additional text which is here to make this more than 255 characters"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMPrivateDnsTxtRecord_updateRecords(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%[1]d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_private_dns_txt_record" "test" {
+  name                = "myrecord%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_private_dns_zone.test.name}"
+  ttl                 = 300
+
+  record {
+    value = "Quick brown fox"
+  }
+
+  record {
+    value = "A long text value which is more than 255 characters long is split into multiple strings by the API, so this value checks that it round trips correctly - since the value needs to be long enough to be split up, this is padded out with some additional text which is here to make this more than 255 characters"
+  }
+
+  record {
+    value = "A wild 3rd record appears"
+  }
+}
+`, rInt, location)
+}
+
+func testAccAzureRMPrivateDnsTxtRecord_withTags(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%[1]d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_private_dns_txt_record" "test" {
+  name                = "myrecord%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_private_dns_zone.test.name}"
+  ttl                 = 300
+
+  record {
+    value = "Quick brown fox"
+  }
+
+  record {
+    value = "A long text value which is more than 255 characters long is split into multiple strings by the API, so this value checks that it round trips correctly - since the value needs to be long enough to be split up, this is padded out with some additional text which is here to make this more than 255 characters"
+  }
+
+  tags = {
+    environment = "Production"
+    cost_center = "MSFT"
+  }
+}
+`, rInt, location)
+}
+
+func testAccAzureRMPrivateDnsTxtRecord_withTagsUpdate(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%[1]d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_private_dns_txt_record" "test" {
+  name                = "myrecord%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_private_dns_zone.test.name}"
+  ttl                 = 300
+
+  record {
+    value = "Quick brown fox"
+  }
+
+  record {
+    value = "A long text value which is more than 255 characters long is split into multiple strings by the API, so this value checks that it round trips correctly - since the value needs to be long enough to be split up, this is padded out with some additional text which is here to make this more than 255 characters"
+  }
+
+  tags = {
+    environment = "staging"
+  }
+}
+`, rInt, location)
+}
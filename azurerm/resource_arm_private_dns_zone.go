@@ -0,0 +1,190 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmPrivateDnsZone() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPrivateDnsZoneCreateUpdate,
+		Read:   resourceArmPrivateDnsZoneRead,
+		Update: resourceArmPrivateDnsZoneCreateUpdate,
+		Delete: resourceArmPrivateDnsZoneDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: naming.Validate("azurerm_private_dns_zone"),
+			},
+
+			"resource_group_name": resourceGroupNameDiffSuppressSchema(),
+
+			"number_of_record_sets": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"max_number_of_record_sets": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"max_number_of_virtual_network_links": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"max_number_of_virtual_network_links_with_registration": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmPrivateDnsZoneCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).privateDnsZonesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	if meta.(*ArmClient).features.requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Private DNS Zone %q (Resource Group %q): %s", name, resGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_private_dns_zone", *existing.ID)
+		}
+	}
+
+	location := "global"
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := privatedns.PrivateZone{
+		Location: &location,
+		Tags:     expandTags(tags),
+	}
+
+	etag := ""
+	ifNoneMatch := "" // set to empty to allow updates to records after creation
+	future, err := client.CreateOrUpdate(ctx, resGroup, name, parameters, etag, ifNoneMatch)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Private DNS Zone %q (Resource Group %q): %s", name, resGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Private DNS Zone %q (Resource Group %q): %s", name, resGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Private DNS Zone %q (Resource Group %q): %s", name, resGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Private DNS Zone %q (Resource Group %q) ID", name, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmPrivateDnsZoneRead(d, meta)
+}
+
+func resourceArmPrivateDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).privateDnsZonesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParsePrivateDnsZoneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	name := id.Name
+
+	resp, err := client.Get(ctx, resGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Private DNS Zone %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+
+	if props := resp.PrivateZoneProperties; props != nil {
+		d.Set("number_of_record_sets", props.NumberOfRecordSets)
+		d.Set("max_number_of_record_sets", props.MaxNumberOfRecordSets)
+		d.Set("max_number_of_virtual_network_links", props.MaxNumberOfVirtualNetworkLinks)
+		d.Set("max_number_of_virtual_network_links_with_registration", props.MaxNumberOfVirtualNetworkLinksWithRegistration)
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmPrivateDnsZoneDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).privateDnsZonesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParsePrivateDnsZoneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	name := id.Name
+
+	etag := ""
+	future, err := client.Delete(ctx, resGroup, name, etag)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Private DNS Zone %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error waiting for deletion of Private DNS Zone %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	return nil
+}
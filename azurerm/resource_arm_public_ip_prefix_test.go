@@ -122,6 +122,35 @@ func TestAccAzureRMPublicIpPrefix_basic(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMPublicIpPrefix_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImportedFromEnvironment() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_public_ip_prefix.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPublicIPPrefixDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPublicIPPrefix_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPublicIPPrefixExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMPublicIPPrefix_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_public_ip_prefix"),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMPublicIpPrefix_prefixLength(t *testing.T) {
 	resourceName := "azurerm_public_ip_prefix.test"
 	ri := tf.AccRandTimeInt()
@@ -219,6 +248,18 @@ resource "azurerm_public_ip_prefix" "test" {
 `, rInt, location, rInt)
 }
 
+func testAccAzureRMPublicIPPrefix_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_public_ip_prefix" "import" {
+  name                = "${azurerm_public_ip_prefix.test.name}"
+  location            = "${azurerm_public_ip_prefix.test.location}"
+  resource_group_name = "${azurerm_public_ip_prefix.test.resource_group_name}"
+}
+`, testAccAzureRMPublicIPPrefix_basic(rInt, location))
+}
+
 func testAccAzureRMPublicIPPrefix_withTags(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -40,7 +40,7 @@ func TestAccAzureRMRecoveryServicesVault_basic(t *testing.T) {
 }
 
 func TestAccAzureRMRecoveryServicesVault_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -43,7 +44,7 @@ func TestAzureRMRedisFirewallRuleName_validation(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		_, errors := validateRedisFirewallRuleName(tc.Value, "azurerm_redis_firewall_rule")
+		_, errors := naming.Validate("azurerm_redis_firewall_rule")(tc.Value, "azurerm_redis_firewall_rule")
 
 		if len(errors) != tc.ErrCount {
 			t.Fatalf("Expected the Redis Firewall Rule Name to trigger a validation error for '%s'", tc.Value)
@@ -76,7 +77,7 @@ func TestAccAzureRMRedisFirewallRule_basic(t *testing.T) {
 }
 
 func TestAccAzureRMRedisFirewallRule_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -39,7 +39,7 @@ func TestAccAzureRMRelayNamespace_basic(t *testing.T) {
 	})
 }
 func TestAccAzureRMRelayNamespace_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -6,10 +6,34 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/armmock"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
+func TestAzureRMResourceGroup_mockServer(t *testing.T) {
+	server := armmock.NewServer()
+	defer server.Close()
+
+	client := testOfflineArmClient(server.URL, offlineSubscriptionId, server.Transport())
+	testCheckAzureRMResourceGroupLifecycle(t, client, "westeurope")
+
+	if _, exists := server.Resource(fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-lifecycle", offlineSubscriptionId)); exists {
+		t.Fatalf("Expected the Resource Group to have been deleted from the fake API")
+	}
+}
+
+// NOTE: the `resource_group_lifecycle` cassette is synthetic - it was written by hand from the
+// Resource Groups API reference rather than recorded against Azure, so it should be re-recorded
+// (with `ARM_TEST_RECORDER_MODE=record`) the next time credentials are available
+func TestAzureRMResourceGroup_recorded(t *testing.T) {
+	client, location, stop := testRecordedArmClient(t, "resource_group_lifecycle")
+	defer stop()
+
+	testCheckAzureRMResourceGroupLifecycle(t, client, location)
+}
+
 func TestAccAzureRMResourceGroup_basic(t *testing.T) {
 	resourceName := "azurerm_resource_group.test"
 	ri := tf.AccRandTimeInt()
@@ -35,7 +59,7 @@ func TestAccAzureRMResourceGroup_basic(t *testing.T) {
 }
 
 func TestAccAzureRMResourceGroup_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -251,3 +275,48 @@ resource "azurerm_resource_group" "test" {
 }
 `, rInt, location)
 }
+
+// testCheckAzureRMResourceGroupLifecycle calls the Create, Read, Update and Delete functions
+// for a Resource Group directly using the specified client, rather than via Terraform Core
+func testCheckAzureRMResourceGroupLifecycle(t *testing.T, client *ArmClient, location string) {
+	r := resourceArmResourceGroup()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":     "acctestRG-lifecycle",
+		"location": location,
+		"tags": map[string]interface{}{
+			"environment": "Production",
+		},
+	})
+
+	if err := r.Create(d, client); err != nil {
+		t.Fatalf("Error creating Resource Group: %+v", err)
+	}
+
+	if d.Id() == "" {
+		t.Fatalf("Expected an ID to be set for the Resource Group")
+	}
+	if v := d.Get("location").(string); v != azureRMNormalizeLocation(location) {
+		t.Fatalf("Expected the location to be %q but got %q", azureRMNormalizeLocation(location), v)
+	}
+
+	if err := d.Set("tags", map[string]interface{}{"environment": "Staging"}); err != nil {
+		t.Fatalf("Error setting tags: %+v", err)
+	}
+	if err := r.Update(d, client); err != nil {
+		t.Fatalf("Error updating Resource Group: %+v", err)
+	}
+	if v := d.Get("tags.environment").(string); v != "Staging" {
+		t.Fatalf("Expected the `environment` tag to be `Staging` but got %q", v)
+	}
+
+	if err := r.Delete(d, client); err != nil {
+		t.Fatalf("Error deleting Resource Group: %+v", err)
+	}
+
+	if err := r.Read(d, client); err != nil {
+		t.Fatalf("Error reading Resource Group: %+v", err)
+	}
+	if d.Id() != "" {
+		t.Fatalf("Expected the Resource Group to have been removed from the State")
+	}
+}
@@ -92,7 +92,7 @@ func testAccAzureRMRoleAssignment_roleName(t *testing.T) {
 }
 
 func testAccAzureRMRoleAssignment_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
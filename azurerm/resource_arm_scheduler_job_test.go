@@ -41,7 +41,7 @@ func TestAccAzureRMSchedulerJob_web_basic(t *testing.T) {
 }
 
 func TestAccAzureRMSchedulerJob_web_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -60,7 +60,7 @@ func testAccAzureRMSecurityCenterContact_basic(t *testing.T) {
 }
 
 func testAccAzureRMSecurityCenterContact_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -43,7 +43,7 @@ func testAccAzureRMSecurityCenterWorkspace_basic(t *testing.T) {
 }
 
 func testAccAzureRMSecurityCenterWorkspace_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -46,7 +46,7 @@ func TestAccAzureRMServiceFabricCluster_basic(t *testing.T) {
 }
 
 func TestAccAzureRMServiceFabricCluster_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
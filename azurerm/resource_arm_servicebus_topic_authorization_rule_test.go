@@ -60,7 +60,7 @@ func testAccAzureRMServiceBusTopicAuthorizationRule(t *testing.T, listen, send,
 }
 
 func TestAccAzureRMServiceBusTopicAuthorizationRule_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -35,7 +35,7 @@ func TestAccAzureRMServiceBusTopic_basic(t *testing.T) {
 	})
 }
 func TestAccAzureRMServiceBusTopic_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
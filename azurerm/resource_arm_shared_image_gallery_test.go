@@ -37,7 +37,7 @@ func TestAccAzureRMSharedImageGallery_basic(t *testing.T) {
 	})
 }
 func TestAccAzureRMSharedImageGallery_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
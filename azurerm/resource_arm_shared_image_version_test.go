@@ -61,7 +61,7 @@ func TestAccAzureRMSharedImageVersion_basic(t *testing.T) {
 	})
 }
 func TestAccAzureRMSharedImageVersion_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -44,7 +44,7 @@ func TestAccAzureRMSignalRService_basic(t *testing.T) {
 	})
 }
 func TestAccAzureRMSignalRService_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
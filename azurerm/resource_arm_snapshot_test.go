@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -52,7 +53,7 @@ func TestSnapshotName_validation(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		_, errors := validateSnapshotName(tc.Value, "azurerm_snapshot")
+		_, errors := naming.Validate("azurerm_snapshot")(tc.Value, "azurerm_snapshot")
 
 		if len(errors) != tc.ErrCount {
 			t.Fatalf("Expected the Snapshot Name to trigger a validation error for '%s'", tc.Value)
@@ -84,7 +85,7 @@ func TestAccAzureRMSnapshot_fromManagedDisk(t *testing.T) {
 	})
 }
 func TestAccAzureRMSnapshot_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -42,7 +42,7 @@ func TestAccAzureRMSqlAdministrator_basic(t *testing.T) {
 	})
 }
 func TestAccAzureRMSqlAdministrator_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
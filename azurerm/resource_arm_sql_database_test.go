@@ -36,7 +36,7 @@ func TestAccAzureRMSqlDatabase_basic(t *testing.T) {
 	})
 }
 func TestAccAzureRMSqlDatabase_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
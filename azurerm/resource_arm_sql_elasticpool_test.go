@@ -34,7 +34,7 @@ func TestAccAzureRMSqlElasticPool_basic(t *testing.T) {
 	})
 }
 func TestAccAzureRMSqlElasticPool_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
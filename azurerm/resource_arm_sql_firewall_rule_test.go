@@ -44,7 +44,7 @@ func TestAccAzureRMSqlFirewallRule_basic(t *testing.T) {
 	})
 }
 func TestAccAzureRMSqlFirewallRule_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -51,7 +52,7 @@ func TestAccAzureRMSqlVirtualNetworkRule_basic(t *testing.T) {
 }
 
 func TestAccAzureRMSqlVirtualNetworkRule_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -271,7 +272,7 @@ func TestResourceAzureRMSqlVirtualNetworkRule_invalidNameValidation(t *testing.T
 	}
 
 	for _, tc := range cases {
-		_, errors := validateSqlVirtualNetworkRuleName(tc.Value, "azurerm_sql_virtual_network_rule")
+		_, errors := naming.Validate("azurerm_sql_virtual_network_rule")(tc.Value, "azurerm_sql_virtual_network_rule")
 
 		if len(errors) != tc.ErrCount {
 			t.Fatalf("Bad: Expected the Azure RM SQL Virtual Network Rule Name to trigger a validation error.")
@@ -336,7 +337,7 @@ func TestResourceAzureRMSqlVirtualNetworkRule_validNameValidation(t *testing.T)
 	}
 
 	for _, tc := range cases {
-		_, errors := validateSqlVirtualNetworkRuleName(tc.Value, "azurerm_sql_virtual_network_rule")
+		_, errors := naming.Validate("azurerm_sql_virtual_network_rule")(tc.Value, "azurerm_sql_virtual_network_rule")
 
 		if len(errors) != tc.ErrCount {
 			t.Fatalf("Bad: Expected the Azure RM SQL Virtual Network Rule Name pass name validation successfully but triggered a validation error.")
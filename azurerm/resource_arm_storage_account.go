@@ -1,17 +1,24 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2018-02-01/storage"
+	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/go-getter/helper/url"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -30,12 +37,19 @@ func resourceArmStorageAccount() *schema.Resource {
 		MigrateState:  resourceStorageAccountMigrateState,
 		SchemaVersion: 2,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArmStorageAccountName,
+				ValidateFunc: naming.Validate("azurerm_storage_account"),
 			},
 
 			"resource_group_name": resourceGroupNameDiffSuppressSchema(),
@@ -189,6 +203,100 @@ func resourceArmStorageAccount() *schema.Resource {
 				},
 			},
 
+			"blob_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cors_rule": azure.SchemaStorageAccountCorsRule(),
+
+						"delete_retention_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      7,
+										ValidateFunc: validation.IntBetween(1, 365),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"queue_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"logging": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"version": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+									"delete": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"read": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"write": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"retention_policy_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 365),
+									},
+								},
+							},
+						},
+
+						"hour_metrics": azure.SchemaStorageAccountQueueMetrics(),
+
+						"minute_metrics": azure.SchemaStorageAccountQueueMetrics(),
+					},
+				},
+			},
+
+			"static_website": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"index_document": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"error_404_document": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
 			"primary_location": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -416,13 +524,14 @@ func validateAzureRMStorageAccountTags(v interface{}, _ string) (warnings []stri
 }
 
 func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).storageServiceClient
 
 	storageAccountName := d.Get("name").(string)
 	resourceGroupName := d.Get("resource_group_name").(string)
 
-	if requireResourcesToBeImported {
+	if meta.(*ArmClient).features.requireResourcesToBeImported {
 		existing, err := client.GetProperties(ctx, resourceGroupName, storageAccountName)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -505,6 +614,10 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if err := validateStorageAccountServiceProperties(d, accountKind, accountTier); err != nil {
+		return err
+	}
+
 	// Create
 	future, err := client.Create(ctx, resourceGroupName, storageAccountName, parameters)
 	if err != nil {
@@ -527,6 +640,29 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[INFO] storage account %q ID: %q", storageAccountName, *account.ID)
 	d.SetId(*account.ID)
 
+	// the Blob and Queue Service Properties are managed using the Data Plane, once the Storage Account exists
+	_, hasBlobProperties := d.GetOk("blob_properties")
+	_, hasStaticWebsite := d.GetOk("static_website")
+	if hasBlobProperties || hasStaticWebsite {
+		props := storageBlobServiceProperties{}
+		if hasBlobProperties {
+			expandStorageAccountBlobProperties(d.Get("blob_properties").([]interface{}), &props)
+		}
+		if hasStaticWebsite {
+			props.StaticWebsite = expandStorageAccountStaticWebsite(d.Get("static_website").([]interface{}))
+		}
+
+		if err := setStorageAccountBlobServiceProperties(ctx, meta, resourceGroupName, storageAccountName, props); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("queue_properties"); ok {
+		if err := setStorageAccountQueueServiceProperties(ctx, meta, resourceGroupName, storageAccountName, v.([]interface{})); err != nil {
+			return err
+		}
+	}
+
 	return resourceArmStorageAccountRead(d, meta)
 }
 
@@ -534,13 +670,14 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 // and idempotent operation for CreateOrUpdate. In particular updating all of the parameters
 // available requires a call to Update per parameter...
 func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).storageServiceClient
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseStorageAccountID(d.Id())
 	if err != nil {
 		return err
 	}
-	storageAccountName := id.Path["storageAccounts"]
+	storageAccountName := id.Name
 	resourceGroupName := id.ResourceGroup
 
 	accountTier := d.Get("account_tier").(string)
@@ -694,20 +831,53 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("network_rules")
 	}
 
+	if d.HasChange("blob_properties") || d.HasChange("static_website") || d.HasChange("queue_properties") {
+		if err := validateStorageAccountServiceProperties(d, accountKind, accountTier); err != nil {
+			return err
+		}
+	}
+
+	// only the properties which have changed are sent, since the others are left unchanged by the Data Plane API
+	if d.HasChange("blob_properties") || d.HasChange("static_website") {
+		props := storageBlobServiceProperties{}
+		if d.HasChange("blob_properties") {
+			expandStorageAccountBlobProperties(d.Get("blob_properties").([]interface{}), &props)
+		}
+		if d.HasChange("static_website") {
+			props.StaticWebsite = expandStorageAccountStaticWebsite(d.Get("static_website").([]interface{}))
+		}
+
+		if err := setStorageAccountBlobServiceProperties(ctx, meta, resourceGroupName, storageAccountName, props); err != nil {
+			return err
+		}
+
+		d.SetPartial("blob_properties")
+		d.SetPartial("static_website")
+	}
+
+	if d.HasChange("queue_properties") {
+		if err := setStorageAccountQueueServiceProperties(ctx, meta, resourceGroupName, storageAccountName, d.Get("queue_properties").([]interface{})); err != nil {
+			return err
+		}
+
+		d.SetPartial("queue_properties")
+	}
+
 	d.Partial(false)
 	return resourceArmStorageAccountRead(d, meta)
 }
 
 func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).storageServiceClient
 	endpointSuffix := meta.(*ArmClient).environment.StorageEndpointSuffix
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseStorageAccountID(d.Id())
 	if err != nil {
 		return err
 	}
-	name := id.Path["storageAccounts"]
+	name := id.Name
 	resGroup := id.ResourceGroup
 
 	resp, err := client.GetProperties(ctx, resGroup, name)
@@ -811,20 +981,29 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
+	var accountTier string
+	if sku := resp.Sku; sku != nil {
+		accountTier = string(sku.Tier)
+	}
+	if err := readStorageAccountServiceProperties(ctx, d, meta, resGroup, name, string(resp.Kind), accountTier); err != nil {
+		return err
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
 }
 
 func resourceArmStorageAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).storageServiceClient
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseStorageAccountID(d.Id())
 	if err != nil {
 		return err
 	}
-	name := id.Path["storageAccounts"]
+	name := id.Name
 	resourceGroup := id.ResourceGroup
 
 	read, err := client.GetProperties(ctx, resourceGroup, name)
@@ -871,6 +1050,229 @@ func resourceArmStorageAccountDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+// validateStorageAccountServiceProperties ensures the Blob and Queue Service Properties are supported by the kind and
+// tier of Storage Account, since the Data Plane API otherwise returns an opaque error
+func validateStorageAccountServiceProperties(d *schema.ResourceData, accountKind, accountTier string) error {
+	if _, ok := d.GetOk("static_website"); ok {
+		if !strings.EqualFold(accountKind, string(storage.StorageV2)) || !strings.EqualFold(accountTier, string(storage.Standard)) {
+			return fmt.Errorf("`static_website` can only be set when the `account_kind` is set to `StorageV2` and the `account_tier` is set to `Standard`")
+		}
+	}
+
+	if _, ok := d.GetOk("blob_properties"); ok && !storageAccountSupportsBlobServiceProperties(accountTier) {
+		return fmt.Errorf("`blob_properties` can only be set when the `account_tier` is set to `Standard`")
+	}
+
+	if _, ok := d.GetOk("queue_properties"); ok && !storageAccountSupportsQueueServiceProperties(accountKind, accountTier) {
+		return fmt.Errorf("`queue_properties` can only be set when the `account_tier` is set to `Standard` and the `account_kind` is set to `Storage` or `StorageV2`")
+	}
+
+	return nil
+}
+
+func storageAccountSupportsBlobServiceProperties(accountTier string) bool {
+	return strings.EqualFold(accountTier, string(storage.Standard))
+}
+
+func storageAccountSupportsQueueServiceProperties(accountKind, accountTier string) bool {
+	return strings.EqualFold(accountTier, string(storage.Standard)) && !strings.EqualFold(accountKind, string(storage.BlobStorage))
+}
+
+func setStorageAccountBlobServiceProperties(ctx context.Context, meta interface{}, resourceGroupName, storageAccountName string, props storageBlobServiceProperties) error {
+	client, accountExists, err := meta.(*ArmClient).getBlobServicePropertiesClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Unable to locate Storage Account %q (Resource Group %q)", storageAccountName, resourceGroupName)
+	}
+
+	if err := client.SetServiceProperties(ctx, props); err != nil {
+		return fmt.Errorf("Error updating Blob Service Properties for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroupName, err)
+	}
+
+	return nil
+}
+
+func setStorageAccountQueueServiceProperties(ctx context.Context, meta interface{}, resourceGroupName, storageAccountName string, input []interface{}) error {
+	client, accountExists, err := meta.(*ArmClient).getQueueServiceClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Unable to locate Storage Account %q (Resource Group %q)", storageAccountName, resourceGroupName)
+	}
+
+	props := mainStorage.ServiceProperties{
+		Logging:       azure.ExpandStorageAccountQueueLogging(nil),
+		HourMetrics:   azure.ExpandStorageAccountQueueMetrics(nil),
+		MinuteMetrics: azure.ExpandStorageAccountQueueMetrics(nil),
+	}
+	if len(input) > 0 && input[0] != nil {
+		v := input[0].(map[string]interface{})
+		props.Logging = azure.ExpandStorageAccountQueueLogging(v["logging"].([]interface{}))
+		props.HourMetrics = azure.ExpandStorageAccountQueueMetrics(v["hour_metrics"].([]interface{}))
+		props.MinuteMetrics = azure.ExpandStorageAccountQueueMetrics(v["minute_metrics"].([]interface{}))
+	}
+
+	if err := client.SetServiceProperties(props); err != nil {
+		return fmt.Errorf("Error updating Queue Service Properties for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroupName, err)
+	}
+
+	return nil
+}
+
+// readStorageAccountServiceProperties sets the Blob and Queue Service Properties, which are retrieved using the Data
+// Plane - when the Data Plane can't be accessed (for example due to the Firewall configured in `network_rules`) or
+// can't be reached (for example when it's only reachable through a Private Endpoint) these are left unchanged,
+// rather than failing the refresh
+func readStorageAccountServiceProperties(ctx context.Context, d *schema.ResourceData, meta interface{}, resourceGroupName, storageAccountName, accountKind, accountTier string) error {
+	if storageAccountSupportsBlobServiceProperties(accountTier) {
+		client, accountExists, err := meta.(*ArmClient).getBlobServicePropertiesClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+		if err != nil {
+			return err
+		}
+
+		if accountExists {
+			props, err := client.GetServiceProperties(ctx)
+			if err != nil {
+				if !storageServicePropertiesInaccessible(err) {
+					return fmt.Errorf("Error retrieving Blob Service Properties for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroupName, err)
+				}
+
+				log.Printf("[WARN] Unable to access the Blob Service Properties for Storage Account %q (Resource Group %q) - leaving `blob_properties` and `static_website` unchanged: %+v", storageAccountName, resourceGroupName, err)
+			} else {
+				if err := d.Set("blob_properties", flattenStorageAccountBlobProperties(props)); err != nil {
+					return fmt.Errorf("Error setting `blob_properties`: %+v", err)
+				}
+				if err := d.Set("static_website", flattenStorageAccountStaticWebsite(props.StaticWebsite)); err != nil {
+					return fmt.Errorf("Error setting `static_website`: %+v", err)
+				}
+			}
+		}
+	}
+
+	if storageAccountSupportsQueueServiceProperties(accountKind, accountTier) {
+		client, accountExists, err := meta.(*ArmClient).getQueueServiceClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+		if err != nil {
+			return err
+		}
+
+		if accountExists {
+			props, err := client.GetServiceProperties()
+			if err != nil {
+				if !storageServicePropertiesInaccessible(err) {
+					return fmt.Errorf("Error retrieving Queue Service Properties for Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroupName, err)
+				}
+
+				log.Printf("[WARN] Unable to access the Queue Service Properties for Storage Account %q (Resource Group %q) - leaving `queue_properties` unchanged: %+v", storageAccountName, resourceGroupName, err)
+			} else {
+				if err := d.Set("queue_properties", flattenStorageAccountQueueProperties(props)); err != nil {
+					return fmt.Errorf("Error setting `queue_properties`: %+v", err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandStorageAccountBlobProperties(input []interface{}, props *storageBlobServiceProperties) {
+	props.Cors = azure.ExpandStorageAccountCorsRules([]interface{}{})
+	props.DeleteRetentionPolicy = &storageDeleteRetentionPolicy{
+		Enabled: false,
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return
+	}
+
+	v := input[0].(map[string]interface{})
+	props.Cors = azure.ExpandStorageAccountCorsRules(v["cors_rule"].([]interface{}))
+
+	if policies := v["delete_retention_policy"].([]interface{}); len(policies) > 0 && policies[0] != nil {
+		policy := policies[0].(map[string]interface{})
+		days := policy["days"].(int)
+		props.DeleteRetentionPolicy = &storageDeleteRetentionPolicy{
+			Enabled: true,
+			Days:    &days,
+		}
+	}
+}
+
+func flattenStorageAccountBlobProperties(input *storageBlobServiceProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	deleteRetentionPolicies := make([]interface{}, 0)
+	if policy := input.DeleteRetentionPolicy; policy != nil && policy.Enabled {
+		days := 0
+		if policy.Days != nil {
+			days = *policy.Days
+		}
+
+		deleteRetentionPolicies = append(deleteRetentionPolicies, map[string]interface{}{
+			"days": days,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cors_rule":               azure.FlattenStorageAccountCorsRules(input.Cors),
+			"delete_retention_policy": deleteRetentionPolicies,
+		},
+	}
+}
+
+func expandStorageAccountStaticWebsite(input []interface{}) *storageStaticWebsite {
+	if len(input) == 0 {
+		return &storageStaticWebsite{
+			Enabled: false,
+		}
+	}
+
+	website := storageStaticWebsite{
+		Enabled: true,
+	}
+
+	// all of the fields are optional, so the block can be empty
+	if input[0] != nil {
+		v := input[0].(map[string]interface{})
+		website.IndexDocument = v["index_document"].(string)
+		website.ErrorDocument404Path = v["error_404_document"].(string)
+	}
+
+	return &website
+}
+
+func flattenStorageAccountStaticWebsite(input *storageStaticWebsite) []interface{} {
+	if input == nil || !input.Enabled {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"index_document":     input.IndexDocument,
+			"error_404_document": input.ErrorDocument404Path,
+		},
+	}
+}
+
+func flattenStorageAccountQueueProperties(input *mainStorage.ServiceProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"logging":        azure.FlattenStorageAccountQueueLogging(input.Logging),
+			"hour_metrics":   azure.FlattenStorageAccountQueueMetrics(input.HourMetrics),
+			"minute_metrics": azure.FlattenStorageAccountQueueMetrics(input.MinuteMetrics),
+		},
+	}
+}
+
 func expandStorageAccountCustomDomain(d *schema.ResourceData) *storage.CustomDomain {
 	domains := d.Get("custom_domain").([]interface{})
 	if len(domains) == 0 {
@@ -1008,16 +1410,6 @@ func flattenStorageAccountBypass(input storage.Bypass) []interface{} {
 	return bypass
 }
 
-func validateArmStorageAccountName(v interface{}, _ string) (warnings []string, errors []error) {
-	input := v.(string)
-
-	if !regexp.MustCompile(`\A([a-z0-9]{3,24})\z`).MatchString(input) {
-		errors = append(errors, fmt.Errorf("name can only consist of lowercase letters and numbers, and must be between 3 and 24 characters long"))
-	}
-
-	return warnings, errors
-}
-
 func validateArmStorageAccountType(v interface{}, _ string) (warnings []string, errors []error) {
 	validAccountTypes := []string{"standard_lrs", "standard_zrs",
 		"standard_grs", "standard_ragrs", "premium_lrs"}
@@ -2,20 +2,26 @@ package azurerm
 
 import (
 	"bytes"
-	"crypto/rand"
+	"context"
+	"crypto/md5"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
+	"net/http"
 	"net/url"
 	"os"
 	"runtime"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tracing"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -23,6 +29,8 @@ import (
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 )
 
 func resourceArmStorageBlob() *schema.Resource {
@@ -31,17 +39,26 @@ func resourceArmStorageBlob() *schema.Resource {
 		Read:          resourceArmStorageBlobRead,
 		Update:        resourceArmStorageBlobUpdate,
 		Delete:        resourceArmStorageBlobDelete,
+		CustomizeDiff: resourceArmStorageBlobCustomizeDiff,
 		MigrateState:  resourceStorageBlobMigrateState,
 		SchemaVersion: 1,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: naming.Validate("azurerm_storage_blob"),
 			},
 
 			"resource_group_name": resourceGroupNameSchema(),
@@ -84,14 +101,32 @@ func resourceArmStorageBlob() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source_uri"},
+				ConflictsWith: []string{"source_uri", "source_content"},
 			},
 
-			"source_uri": {
+			"source_content": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      true,
-				ConflictsWith: []string{"source"},
+				ConflictsWith: []string{"source", "source_uri"},
+			},
+
+			"source_uri": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"source", "source_content"},
+				DiffSuppressFunc: resourceArmStorageBlobSourceURIDiffSuppress,
+			},
+
+			"copy_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.Duration,
+			},
+
+			"content_md5": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 
 			"url": {
@@ -129,7 +164,8 @@ func resourceArmStorageBlob() *schema.Resource {
 
 func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForCreate(armClient.StopContext, d)
+	defer cancel()
 	env := armClient.environment
 
 	resourceGroupName := d.Get("resource_group_name").(string)
@@ -155,7 +191,7 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 
 	// gives us https://example.blob.core.windows.net/container/file.vhd
 	id := fmt.Sprintf("https://%s.blob.%s/%s/%s", storageAccountName, env.StorageEndpointSuffix, containerName, name)
-	if requireResourcesToBeImported && d.IsNewResource() {
+	if meta.(*ArmClient).features.requireResourcesToBeImported && d.IsNewResource() {
 		exists, err := blob.Exists()
 		if err != nil {
 			return fmt.Errorf("Error checking if Blob %q exists (Container %q / Account %q / Resource Group %q): %s", name, containerName, storageAccountName, resourceGroupName, err)
@@ -167,8 +203,16 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if sourceUri != "" {
-		options := &storage.CopyOptions{}
-		if err := blob.Copy(sourceUri, options); err != nil {
+		copyTimeout := time.Duration(0)
+		if v := d.Get("copy_timeout").(string); v != "" {
+			copyTimeout, _ = time.ParseDuration(v)
+		}
+
+		if err := resourceArmStorageBlobCopyFromSource(ctx, blob, sourceUri, copyTimeout); err != nil {
+			return fmt.Errorf("Error copying storage blob %q from %q: %s", name, storageBlobRedactSourceURI(sourceUri), err)
+		}
+	} else if d.Get("source").(string) != "" || d.Get("source_content").(string) != "" {
+		if err := resourceArmStorageBlobUpload(d, blobClient, containerName, name); err != nil {
 			return fmt.Errorf("Error creating storage blob on Azure: %s", err)
 		}
 	} else {
@@ -178,34 +222,14 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 			if err := blob.CreateBlockBlob(options); err != nil {
 				return fmt.Errorf("Error creating storage blob on Azure: %s", err)
 			}
-
-			source := d.Get("source").(string)
-			if source != "" {
-				parallelism := d.Get("parallelism").(int)
-				attempts := d.Get("attempts").(int)
-
-				if err := resourceArmStorageBlobBlockUploadFromSource(containerName, name, source, contentType, blobClient, parallelism, attempts); err != nil {
-					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
-				}
-			}
 		case "page":
-			source := d.Get("source").(string)
-			if source != "" {
-				parallelism := d.Get("parallelism").(int)
-				attempts := d.Get("attempts").(int)
+			size := int64(d.Get("size").(int))
+			options := &storage.PutBlobOptions{}
 
-				if err := resourceArmStorageBlobPageUploadFromSource(containerName, name, source, contentType, blobClient, parallelism, attempts); err != nil {
-					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
-				}
-			} else {
-				size := int64(d.Get("size").(int))
-				options := &storage.PutBlobOptions{}
-
-				blob.Properties.ContentLength = size
-				blob.Properties.ContentType = contentType
-				if err := blob.PutPageBlob(options); err != nil {
-					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
-				}
+			blob.Properties.ContentLength = size
+			blob.Properties.ContentType = contentType
+			if err := blob.PutPageBlob(options); err != nil {
+				return fmt.Errorf("Error creating storage blob on Azure: %s", err)
 			}
 		}
 	}
@@ -221,12 +245,190 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 	return resourceArmStorageBlobRead(d, meta)
 }
 
+// resourceArmStorageBlobCustomizeDiff calculates the MD5 of the local `source` file or the `source_content`, such
+// that the Blob is uploaded again when the content changes
+func resourceArmStorageBlobCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	var contentMD5 string
+
+	if content := d.Get("source_content").(string); content != "" {
+		if blobType := d.Get("type").(string); !strings.EqualFold(blobType, "block") {
+			return fmt.Errorf("`source_content` can only be specified when `type` is set to `block`")
+		}
+
+		hash := md5.Sum([]byte(content))
+		contentMD5 = hex.EncodeToString(hash[:])
+	} else if source := d.Get("source").(string); source != "" {
+		hash, err := resourceArmStorageBlobFileMD5(source)
+		if err != nil {
+			// the file may not exist until it's created by another resource during the apply
+			log.Printf("[DEBUG] Unable to calculate the MD5 of the source file %q: %+v", source, err)
+			return nil
+		}
+
+		contentMD5 = hash
+	}
+
+	if contentMD5 != "" && contentMD5 != d.Get("content_md5").(string) {
+		return d.SetNew("content_md5", contentMD5)
+	}
+
+	return nil
+}
+
+// resourceArmStorageBlobUpload uploads the `source` file or the `source_content` to the Blob, replacing any existing
+// content
+func resourceArmStorageBlobUpload(d *schema.ResourceData, client *storage.BlobStorageClient, container, name string) error {
+	contentType := d.Get("content_type").(string)
+
+	if content := d.Get("source_content").(string); content != "" {
+		return resourceArmStorageBlobBlockUploadFromContent(container, name, content, contentType, client)
+	}
+
+	source := d.Get("source").(string)
+	parallelism := d.Get("parallelism").(int)
+	attempts := d.Get("attempts").(int)
+
+	contentMD5, err := resourceArmStorageBlobFileMD5(source)
+	if err != nil {
+		return fmt.Errorf("Error calculating the MD5 of source file %q: %s", source, err)
+	}
+
+	switch strings.ToLower(d.Get("type").(string)) {
+	case "block":
+		return resourceArmStorageBlobBlockUploadFromSource(container, name, source, contentType, contentMD5, client, parallelism, attempts)
+	case "page":
+		return resourceArmStorageBlobPageUploadFromSource(container, name, source, contentType, contentMD5, client, parallelism, attempts)
+	}
+
+	return nil
+}
+
+func resourceArmStorageBlobBlockUploadFromContent(container, name, content, contentType string, client *storage.BlobStorageClient) error {
+	hash := md5.Sum([]byte(content))
+
+	containerReference := client.GetContainerReference(container)
+	blobReference := containerReference.GetBlobReference(name)
+	blobReference.Properties.ContentType = contentType
+	blobReference.Properties.ContentMD5 = base64.StdEncoding.EncodeToString(hash[:])
+
+	options := &storage.PutBlobOptions{}
+	if err := blobReference.CreateBlockBlobFromReader(strings.NewReader(content), options); err != nil {
+		return fmt.Errorf("Error uploading content: %s", err)
+	}
+
+	return nil
+}
+
+// resourceArmStorageBlobCopyFromSource copies the source Blob (or Snapshot, or File) into the Blob server-side, waiting
+// for the copy to complete. When the copy fails or doesn't complete within the timeout it's aborted and the
+// destination Blob is removed, so that the copy can be retried.
+func resourceArmStorageBlobCopyFromSource(ctx context.Context, blob *storage.Blob, sourceUri string, timeout time.Duration) error {
+	source := storageBlobRedactSourceURI(sourceUri)
+
+	copyId, err := blob.StartCopy(sourceUri, &storage.CopyOptions{})
+	if err != nil {
+		return fmt.Errorf("Error starting copy: %s", err)
+	}
+
+	if timeout == 0 {
+		deadline, ok := ctx.Deadline()
+		if !ok {
+			return fmt.Errorf("Error determining the timeout for the copy")
+		}
+		timeout = time.Until(deadline)
+	}
+
+	log.Printf("[DEBUG] Waiting for the copy of %q (Copy ID %q) to complete..", source, copyId)
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"pending"},
+		Target:       []string{"success"},
+		Refresh:      storageBlobCopyRefreshFunc(blob, copyId, source),
+		Timeout:      timeout,
+		PollInterval: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		log.Printf("[DEBUG] The copy of %q (Copy ID %q) didn't complete - aborting and removing the destination blob..", source, copyId)
+		if blob.Properties.CopyStatus == "pending" {
+			if abortErr := blob.AbortCopy(copyId, &storage.AbortCopyOptions{}); abortErr != nil {
+				log.Printf("[DEBUG] Error aborting the copy of %q (Copy ID %q): %s", source, copyId, abortErr)
+			}
+		}
+		if _, deleteErr := blob.DeleteIfExists(&storage.DeleteBlobOptions{}); deleteErr != nil {
+			log.Printf("[DEBUG] Error removing the destination blob after the copy of %q failed: %s", source, deleteErr)
+		}
+
+		return fmt.Errorf("Error waiting for copy (Copy ID %q) to complete: %s", copyId, err)
+	}
+
+	return nil
+}
+
+func storageBlobCopyRefreshFunc(blob *storage.Blob, copyId, source string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		if err := blob.GetProperties(&storage.GetBlobPropertiesOptions{}); err != nil {
+			return nil, "", fmt.Errorf("Error retrieving the copy status: %s", err)
+		}
+
+		props := blob.Properties
+		if props.CopyID != copyId {
+			return nil, "", fmt.Errorf("Expected the Copy ID to be %q but got %q - another copy may have been started", copyId, props.CopyID)
+		}
+
+		switch props.CopyStatus {
+		case "pending":
+			// the progress is in the format `{bytes copied}/{total bytes}`
+			log.Printf("[DEBUG] Copy of %q (Copy ID %q) is in progress: %s bytes copied", source, copyId, props.CopyProgress)
+		case "success":
+			log.Printf("[DEBUG] Copy of %q (Copy ID %q) completed: %s bytes copied", source, copyId, props.CopyProgress)
+		case "aborted", "failed":
+			return nil, props.CopyStatus, fmt.Errorf("Copy %s: %s", props.CopyStatus, props.CopyStatusDescription)
+		default:
+			return nil, props.CopyStatus, fmt.Errorf("Unexpected copy status %q", props.CopyStatus)
+		}
+
+		return props, props.CopyStatus, nil
+	}
+}
+
+// resourceArmStorageBlobSourceURIDiffSuppress ignores differences in the query string of the `source_uri` other than
+// the `snapshot` - since the Storage API doesn't return the SAS Token used for the copy
+func resourceArmStorageBlobSourceURIDiffSuppress(_, old, new string, _ *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	oldUri, err := url.Parse(old)
+	if err != nil {
+		return false
+	}
+	newUri, err := url.Parse(new)
+	if err != nil {
+		return false
+	}
+
+	return oldUri.Scheme == newUri.Scheme &&
+		strings.EqualFold(oldUri.Host, newUri.Host) &&
+		oldUri.Path == newUri.Path &&
+		oldUri.Query().Get("snapshot") == newUri.Query().Get("snapshot")
+}
+
+// storageBlobRedactSourceURI redacts the signature of any SAS Token within the URI, so it can be logged
+func storageBlobRedactSourceURI(input string) string {
+	uri, err := url.Parse(input)
+	if err != nil {
+		return tracing.Redacted
+	}
+
+	return tracing.RedactURL(uri)
+}
+
 type resourceArmStorageBlobPage struct {
 	offset  int64
 	section *io.SectionReader
 }
 
-func resourceArmStorageBlobPageUploadFromSource(container, name, source, contentType string, client *storage.BlobStorageClient, parallelism, attempts int) error {
+func resourceArmStorageBlobPageUploadFromSource(container, name, source, contentType, contentMD5 string, client *storage.BlobStorageClient, parallelism, attempts int) error {
 	workerCount := parallelism * runtime.NumCPU()
 
 	file, err := os.Open(source)
@@ -282,6 +484,18 @@ func resourceArmStorageBlobPageUploadFromSource(container, name, source, content
 		return fmt.Errorf("Error while uploading source file %q: %s", source, <-errors)
 	}
 
+	// the MD5 of a Page Blob can only be set once all of the pages have been written
+	if err := blob.GetProperties(&storage.GetBlobPropertiesOptions{}); err != nil {
+		return fmt.Errorf("Error retrieving properties for source file %q: %s", source, err)
+	}
+	blob.Properties.ContentMD5, err = storageBlobContentMD5ToBase64(contentMD5)
+	if err != nil {
+		return err
+	}
+	if err := blob.SetProperties(&storage.SetBlobPropertiesOptions{}); err != nil {
+		return fmt.Errorf("Error setting the MD5 for source file %q: %s", source, err)
+	}
+
 	return nil
 }
 
@@ -404,7 +618,7 @@ type resourceArmStorageBlobBlock struct {
 	id      string
 }
 
-func resourceArmStorageBlobBlockUploadFromSource(container, name, source, contentType string, client *storage.BlobStorageClient, parallelism, attempts int) error {
+func resourceArmStorageBlobBlockUploadFromSource(container, name, source, contentType, contentMD5 string, client *storage.BlobStorageClient, parallelism, attempts int) error {
 	workerCount := parallelism * runtime.NumCPU()
 
 	file, err := os.Open(source)
@@ -413,17 +627,32 @@ func resourceArmStorageBlobBlockUploadFromSource(container, name, source, conten
 	}
 	defer utils.IoCloseAndLogError(file, fmt.Sprintf("Error closing Storage Blob `%s` file `%s` after upload", name, source))
 
-	blockList, parts, err := resourceArmStorageBlobBlockSplit(file)
+	blockList, parts, err := resourceArmStorageBlobBlockSplit(file, contentMD5)
 	if err != nil {
 		return fmt.Errorf("Error reading and splitting source file for upload %q: %s", source, err)
 	}
 
+	containerReference := client.GetContainerReference(container)
+	blobReference := containerReference.GetBlobReference(name)
+
+	// blocks uploaded by an earlier attempt which was interrupted are left uncommitted, and can be re-used
+	uncommitted, err := blobReference.GetBlockList(storage.BlockListTypeUncommitted, &storage.GetBlockListOptions{})
+	if err != nil {
+		if !storageBlobWasNotFound(err) {
+			return fmt.Errorf("Error retrieving uncommitted blocks for source file %q: %s", source, err)
+		}
+	}
+	remaining := resourceArmStorageBlobBlocksToUpload(parts, uncommitted.UncommittedBlocks)
+	if uploaded := len(parts) - len(remaining); uploaded > 0 {
+		log.Printf("[DEBUG] Resuming upload of source file %q - %d of %d blocks have already been uploaded", source, uploaded, len(parts))
+	}
+
 	wg := &sync.WaitGroup{}
-	blocks := make(chan resourceArmStorageBlobBlock, len(parts))
-	errors := make(chan error, len(parts))
+	blocks := make(chan resourceArmStorageBlobBlock, len(remaining))
+	errors := make(chan error, len(remaining))
 
-	wg.Add(len(parts))
-	for _, p := range parts {
+	wg.Add(len(remaining))
+	for _, p := range remaining {
 		blocks <- p
 	}
 	close(blocks)
@@ -447,9 +676,11 @@ func resourceArmStorageBlobBlockUploadFromSource(container, name, source, conten
 		return fmt.Errorf("Error while uploading source file %q: %s", source, <-errors)
 	}
 
-	containerReference := client.GetContainerReference(container)
-	blobReference := containerReference.GetBlobReference(name)
 	blobReference.Properties.ContentType = contentType
+	blobReference.Properties.ContentMD5, err = storageBlobContentMD5ToBase64(contentMD5)
+	if err != nil {
+		return err
+	}
 	options := &storage.PutBlockListOptions{}
 	err = blobReference.PutBlockList(blockList, options)
 	if err != nil {
@@ -459,9 +690,10 @@ func resourceArmStorageBlobBlockUploadFromSource(container, name, source, conten
 	return nil
 }
 
-func resourceArmStorageBlobBlockSplit(file *os.File) ([]storage.Block, []resourceArmStorageBlobBlock, error) {
+// resourceArmStorageBlobBlockSplit splits the file into blocks - where the ID of each block is derived from the MD5
+// of the file and the position of the block, such that the same blocks are used when the upload is retried
+func resourceArmStorageBlobBlockSplit(file *os.File, contentMD5 string) ([]storage.Block, []resourceArmStorageBlobBlock, error) {
 	const (
-		idSize          = 64
 		blockSize int64 = 4 * 1024 * 1024
 	)
 	var parts []resourceArmStorageBlobBlock
@@ -473,20 +705,15 @@ func resourceArmStorageBlobBlockSplit(file *os.File) ([]storage.Block, []resourc
 	}
 
 	for i := int64(0); i < info.Size(); i = i + blockSize {
-		entropy := make([]byte, idSize)
-		_, err = rand.Read(entropy)
-		if err != nil {
-			return nil, nil, fmt.Errorf("Error generating a random block ID for source file %q: %s", file.Name(), err)
-		}
-
 		sectionSize := blockSize
 		remainder := info.Size() - i
 		if remainder < blockSize {
 			sectionSize = remainder
 		}
 
+		// the ID's of all blocks within a Blob must be the same length, so the index is zero-padded
 		block := storage.Block{
-			ID:     base64.StdEncoding.EncodeToString(entropy),
+			ID:     base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s-%05d", contentMD5, i/blockSize))),
 			Status: storage.BlockStatusUncommitted,
 		}
 
@@ -501,6 +728,25 @@ func resourceArmStorageBlobBlockSplit(file *os.File) ([]storage.Block, []resourc
 	return blockList, parts, nil
 }
 
+// resourceArmStorageBlobBlocksToUpload returns the blocks which haven't already been uploaded
+func resourceArmStorageBlobBlocksToUpload(parts []resourceArmStorageBlobBlock, uncommitted []storage.BlockResponse) []resourceArmStorageBlobBlock {
+	uploaded := make(map[string]int64)
+	for _, block := range uncommitted {
+		uploaded[block.Name] = block.Size
+	}
+
+	remaining := make([]resourceArmStorageBlobBlock, 0)
+	for _, part := range parts {
+		if size, ok := uploaded[part.id]; ok && size == part.section.Size() {
+			continue
+		}
+
+		remaining = append(remaining, part)
+	}
+
+	return remaining
+}
+
 type resourceArmStorageBlobBlockUploadContext struct {
 	client    *storage.BlobStorageClient
 	container string
@@ -543,7 +789,8 @@ func resourceArmStorageBlobBlockUploadWorker(ctx resourceArmStorageBlobBlockUplo
 
 func resourceArmStorageBlobUpdate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForUpdate(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageBlobID(d.Id(), armClient.environment)
 	if err != nil {
@@ -570,14 +817,61 @@ func resourceArmStorageBlobUpdate(d *schema.ResourceData, meta interface{}) erro
 	container := blobClient.GetContainerReference(id.containerName)
 	blob := container.GetBlobReference(id.blobName)
 
+	if d.HasChange("content_md5") || d.HasChange("source_content") {
+		oldContentMD5, newContentMD5 := d.GetChange("content_md5")
+		action := storageBlobContentUpdateActionFor(d.Get("source").(string), d.Get("source_content").(string), oldContentMD5.(string))
+
+		switch action {
+		case storageBlobContentUpdateNone:
+			log.Printf("[DEBUG] No content is specified for storage blob %q - leaving the existing content as-is", id.blobName)
+
+		case storageBlobContentUpdateSetMD5:
+			// Blobs uploaded before the `content_md5` was tracked don't have a MD5, so rather than uploading
+			// the content again the MD5 of the `source` file is assigned to the existing Blob
+			log.Printf("[DEBUG] Storage blob %q has no MD5 - assigning the MD5 of the source file..", id.blobName)
+			if err := blob.GetProperties(&storage.GetBlobPropertiesOptions{}); err != nil {
+				return fmt.Errorf("Error getting properties of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+			}
+
+			contentMD5, err := storageBlobContentMD5ToBase64(newContentMD5.(string))
+			if err != nil {
+				return err
+			}
+			blob.Properties.ContentMD5 = contentMD5
+
+			if err := blob.SetProperties(&storage.SetBlobPropertiesOptions{}); err != nil {
+				return fmt.Errorf("Error setting properties of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+			}
+
+		case storageBlobContentUpdateUpload:
+			log.Printf("[DEBUG] The content of storage blob %q has changed - uploading..", id.blobName)
+			if err := resourceArmStorageBlobUpload(d, blobClient, id.containerName, id.blobName); err != nil {
+				return fmt.Errorf("Error uploading storage blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+			}
+
+			// uploading the content replaces the Metadata, so it needs to be set again
+			blob.Metadata = expandStorageAccountBlobMetadata(d)
+
+			opts := &storage.SetBlobMetadataOptions{}
+			if err := blob.SetMetadata(opts); err != nil {
+				return fmt.Errorf("Error setting metadata for storage blob on Azure: %s", err)
+			}
+		}
+	}
+
 	if d.HasChange("content_type") {
+		// properties which aren't specified are cleared, so the existing properties (such as the MD5) are retrieved first
+		if err := blob.GetProperties(&storage.GetBlobPropertiesOptions{}); err != nil {
+			return fmt.Errorf("Error getting properties of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+		}
+
 		blob.Properties.ContentType = d.Get("content_type").(string)
-	}
 
-	options := &storage.SetBlobPropertiesOptions{}
-	err = blob.SetProperties(options)
-	if err != nil {
-		return fmt.Errorf("Error setting properties of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+		options := &storage.SetBlobPropertiesOptions{}
+		err = blob.SetProperties(options)
+		if err != nil {
+			return fmt.Errorf("Error setting properties of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+		}
 	}
 
 	if d.HasChange("metadata") {
@@ -589,12 +883,13 @@ func resourceArmStorageBlobUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	return nil
+	return resourceArmStorageBlobRead(d, meta)
 }
 
 func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForRead(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageBlobID(d.Id(), armClient.environment)
 	if err != nil {
@@ -652,6 +947,7 @@ func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("resource_group_name", resourceGroup)
 
 	d.Set("content_type", blob.Properties.ContentType)
+	d.Set("content_md5", storageBlobContentMD5FromBase64(blob.Properties.ContentMD5))
 
 	d.Set("source_uri", blob.Properties.CopySource)
 
@@ -670,7 +966,8 @@ func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmStorageBlobDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForDelete(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageBlobID(d.Id(), armClient.environment)
 	if err != nil {
@@ -782,3 +1079,76 @@ func flattenStorageAccountBlobMetadata(in storage.BlobMetadata) map[string]inter
 
 	return blobMetadata
 }
+
+// resourceArmStorageBlobFileMD5 returns the hex-encoded MD5 of the specified file
+func resourceArmStorageBlobFileMD5(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer utils.IoCloseAndLogError(file, fmt.Sprintf("Error closing file %q after calculating the MD5", path))
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+type storageBlobContentUpdateAction string
+
+const (
+	storageBlobContentUpdateNone   storageBlobContentUpdateAction = "None"
+	storageBlobContentUpdateSetMD5 storageBlobContentUpdateAction = "SetMD5"
+	storageBlobContentUpdateUpload storageBlobContentUpdateAction = "Upload"
+)
+
+// storageBlobContentUpdateActionFor determines how a change to the content of an existing Blob is applied - when
+// neither `source` nor `source_content` are specified (for example `source_content` has been removed) the existing
+// content is left as-is, and a Blob uploaded from the `source` file without a MD5 has the MD5 assigned rather than
+// being uploaded again
+func storageBlobContentUpdateActionFor(source, sourceContent, existingContentMD5 string) storageBlobContentUpdateAction {
+	if sourceContent != "" {
+		return storageBlobContentUpdateUpload
+	}
+
+	if source == "" {
+		return storageBlobContentUpdateNone
+	}
+
+	if existingContentMD5 == "" {
+		return storageBlobContentUpdateSetMD5
+	}
+
+	return storageBlobContentUpdateUpload
+}
+
+// storageBlobContentMD5ToBase64 converts a hex-encoded MD5 into the base64-encoded format used by the Storage API
+func storageBlobContentMD5ToBase64(input string) (string, error) {
+	hash, err := hex.DecodeString(input)
+	if err != nil {
+		return "", fmt.Errorf("Error decoding MD5 %q: %s", input, err)
+	}
+
+	return base64.StdEncoding.EncodeToString(hash), nil
+}
+
+// storageBlobContentMD5FromBase64 converts a base64-encoded MD5 returned from the Storage API into a hex-encoded MD5
+func storageBlobContentMD5FromBase64(input string) string {
+	hash, err := base64.StdEncoding.DecodeString(input)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to decode the MD5 %q: %s", input, err)
+		return ""
+	}
+
+	return hex.EncodeToString(hash)
+}
+
+func storageBlobWasNotFound(err error) bool {
+	if v, ok := err.(storage.AzureStorageServiceError); ok {
+		return v.StatusCode == http.StatusNotFound
+	}
+
+	return false
+}
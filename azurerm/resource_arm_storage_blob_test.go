@@ -1,10 +1,14 @@
 package azurerm
 
 import (
+	"crypto/md5"
 	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"os"
 	"testing"
 
 	"strings"
@@ -16,6 +20,247 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
+func TestResourceArmStorageBlobBlockSplit(t *testing.T) {
+	file, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	if _, err = io.CopyN(file, rand.Reader, 9*1024*1024); err != nil {
+		t.Fatalf("Failed to write random test to source blob")
+	}
+
+	contentMD5, err := resourceArmStorageBlobFileMD5(file.Name())
+	if err != nil {
+		t.Fatalf("Expected no error calculating the MD5 but got: %+v", err)
+	}
+
+	blockList, parts, err := resourceArmStorageBlobBlockSplit(file, contentMD5)
+	if err != nil {
+		t.Fatalf("Expected no error splitting the file but got: %+v", err)
+	}
+
+	if len(blockList) != 3 || len(parts) != 3 {
+		t.Fatalf("Expected 3 blocks but got %d blocks and %d parts", len(blockList), len(parts))
+	}
+	if v := parts[2].section.Size(); v != 1024*1024 {
+		t.Fatalf("Expected the last block to be %d bytes but got %d", 1024*1024, v)
+	}
+
+	for i, block := range blockList {
+		if len(block.ID) != len(blockList[0].ID) {
+			t.Fatalf("Expected all Block ID's to be the same length but %q and %q differ", block.ID, blockList[0].ID)
+		}
+		if block.ID != parts[i].id {
+			t.Fatalf("Expected the Block ID %q to match the part %q", block.ID, parts[i].id)
+		}
+	}
+
+	// the same blocks should be used when the upload is retried
+	retriedBlockList, _, err := resourceArmStorageBlobBlockSplit(file, contentMD5)
+	if err != nil {
+		t.Fatalf("Expected no error splitting the file but got: %+v", err)
+	}
+	for i, block := range retriedBlockList {
+		if block.ID != blockList[i].ID {
+			t.Fatalf("Expected the Block ID's to be the same when retried but got %q and %q", block.ID, blockList[i].ID)
+		}
+	}
+}
+
+func TestResourceArmStorageBlobBlocksToUpload(t *testing.T) {
+	file := strings.NewReader(strings.Repeat("a", 10))
+	parts := []resourceArmStorageBlobBlock{
+		{id: "YmxvY2sx", section: io.NewSectionReader(file, 0, 4)},
+		{id: "YmxvY2sy", section: io.NewSectionReader(file, 4, 4)},
+		{id: "YmxvY2sz", section: io.NewSectionReader(file, 8, 2)},
+	}
+
+	testData := []struct {
+		Name        string
+		Uncommitted []storage.BlockResponse
+		Expected    []string
+	}{
+		{
+			Name:        "Nothing Uploaded",
+			Uncommitted: []storage.BlockResponse{},
+			Expected:    []string{"YmxvY2sx", "YmxvY2sy", "YmxvY2sz"},
+		},
+		{
+			Name: "Partially Uploaded",
+			Uncommitted: []storage.BlockResponse{
+				{Name: "YmxvY2sx", Size: 4},
+				{Name: "YmxvY2sz", Size: 2},
+			},
+			Expected: []string{"YmxvY2sy"},
+		},
+		{
+			Name: "Different Size",
+			Uncommitted: []storage.BlockResponse{
+				{Name: "YmxvY2sx", Size: 3},
+			},
+			Expected: []string{"YmxvY2sx", "YmxvY2sy", "YmxvY2sz"},
+		},
+		{
+			Name: "Fully Uploaded",
+			Uncommitted: []storage.BlockResponse{
+				{Name: "YmxvY2sx", Size: 4},
+				{Name: "YmxvY2sy", Size: 4},
+				{Name: "YmxvY2sz", Size: 2},
+			},
+			Expected: []string{},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := resourceArmStorageBlobBlocksToUpload(parts, v.Uncommitted)
+		if len(actual) != len(v.Expected) {
+			t.Fatalf("Expected %d blocks to upload but got %d", len(v.Expected), len(actual))
+		}
+		for i, block := range actual {
+			if block.id != v.Expected[i] {
+				t.Fatalf("Expected block %d to be %q but got %q", i, v.Expected[i], block.id)
+			}
+		}
+	}
+}
+
+func TestStorageBlobContentMD5_roundTrip(t *testing.T) {
+	hash := md5.Sum([]byte("Hello, World!"))
+	expectedHex := hex.EncodeToString(hash[:])
+	expectedBase64 := base64.StdEncoding.EncodeToString(hash[:])
+
+	actualBase64, err := storageBlobContentMD5ToBase64(expectedHex)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if actualBase64 != expectedBase64 {
+		t.Fatalf("Expected %q but got %q", expectedBase64, actualBase64)
+	}
+
+	if actual := storageBlobContentMD5FromBase64(actualBase64); actual != expectedHex {
+		t.Fatalf("Expected %q but got %q", expectedHex, actual)
+	}
+
+	if actual := storageBlobContentMD5FromBase64(""); actual != "" {
+		t.Fatalf("Expected an empty MD5 to remain empty but got %q", actual)
+	}
+
+	if _, err := storageBlobContentMD5ToBase64("not-hex"); err == nil {
+		t.Fatalf("Expected an error for an invalid MD5 but didn't get one")
+	}
+}
+
+func TestStorageBlobContentUpdateActionFor(t *testing.T) {
+	testData := []struct {
+		Name               string
+		Source             string
+		SourceContent      string
+		ExistingContentMD5 string
+		Expected           storageBlobContentUpdateAction
+	}{
+		{
+			Name:               "Source Content Changed",
+			SourceContent:      "Hello, World!",
+			ExistingContentMD5: "65a8e27d8879283831b664bd8b7f0ad4",
+			Expected:           storageBlobContentUpdateUpload,
+		},
+		{
+			Name:               "Source Content Removed",
+			ExistingContentMD5: "65a8e27d8879283831b664bd8b7f0ad4",
+			Expected:           storageBlobContentUpdateNone,
+		},
+		{
+			Name:               "Source File Changed",
+			Source:             "/tmp/example.vhd",
+			ExistingContentMD5: "65a8e27d8879283831b664bd8b7f0ad4",
+			Expected:           storageBlobContentUpdateUpload,
+		},
+		{
+			Name:     "Source File Uploaded Without a MD5",
+			Source:   "/tmp/example.vhd",
+			Expected: storageBlobContentUpdateSetMD5,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := storageBlobContentUpdateActionFor(v.Source, v.SourceContent, v.ExistingContentMD5); actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestResourceArmStorageBlobSourceURIDiffSuppress(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{
+			Name:     "New Resource",
+			Old:      "",
+			New:      "https://example.blob.core.windows.net/container/source.vhd",
+			Suppress: false,
+		},
+		{
+			Name:     "Same URI",
+			Old:      "https://example.blob.core.windows.net/container/source.vhd",
+			New:      "https://example.blob.core.windows.net/container/source.vhd",
+			Suppress: true,
+		},
+		{
+			Name:     "SAS Token Not Returned",
+			Old:      "https://example.blob.core.windows.net/container/source.vhd",
+			New:      "https://example.blob.core.windows.net/container/source.vhd?sv=2017-07-29&ss=b&srt=o&sp=r&sig=abc123",
+			Suppress: true,
+		},
+		{
+			Name:     "Different Blob",
+			Old:      "https://example.blob.core.windows.net/container/source.vhd",
+			New:      "https://example.blob.core.windows.net/container/other.vhd?sig=abc123",
+			Suppress: false,
+		},
+		{
+			Name:     "Same Snapshot",
+			Old:      "https://example.blob.core.windows.net/container/source.vhd?snapshot=2019-05-01T00:00:00.0000000Z",
+			New:      "https://example.blob.core.windows.net/container/source.vhd?snapshot=2019-05-01T00:00:00.0000000Z&sig=abc123",
+			Suppress: true,
+		},
+		{
+			Name:     "Different Snapshot",
+			Old:      "https://example.blob.core.windows.net/container/source.vhd?snapshot=2019-05-01T00:00:00.0000000Z",
+			New:      "https://example.blob.core.windows.net/container/source.vhd?snapshot=2019-06-01T00:00:00.0000000Z",
+			Suppress: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := resourceArmStorageBlobSourceURIDiffSuppress("source_uri", v.Old, v.New, nil); actual != v.Suppress {
+			t.Fatalf("Expected %t but got %t", v.Suppress, actual)
+		}
+	}
+}
+
+func TestStorageBlobRedactSourceURI(t *testing.T) {
+	input := "https://example.blob.core.windows.net/container/source.vhd?sig=abc123&sv=2017-07-29"
+	actual := storageBlobRedactSourceURI(input)
+	if strings.Contains(actual, "abc123") {
+		t.Fatalf("Expected the signature to be redacted but got %q", actual)
+	}
+	if !strings.Contains(actual, "sv=2017-07-29") {
+		t.Fatalf("Expected the other query string parameters to be retained but got %q", actual)
+	}
+}
+
 func TestAccAzureRMStorageBlob_basic(t *testing.T) {
 	resourceName := "azurerm_storage_blob.test"
 	ri := tf.AccRandTimeInt()
@@ -46,7 +291,7 @@ func TestAccAzureRMStorageBlob_basic(t *testing.T) {
 	})
 }
 func TestAccAzureRMStorageBlob_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -235,6 +480,27 @@ func TestAccAzureRMStorageBlob_source_uri(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageBlob_sourceUriFromOtherAccount(t *testing.T) {
+	resourceName := "azurerm_storage_blob.destination"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageBlob_sourceUriFromOtherAccount(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", "65a8e27d8879283831b664bd8b7f0ad4"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMStorageBlobBlock_blockContentType(t *testing.T) {
 	resourceName := "azurerm_storage_blob.source"
 	ri := tf.AccRandTimeInt()
@@ -280,6 +546,85 @@ func TestAccAzureRMStorageBlobBlock_blockContentType(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageBlobBlock_sourceChanged(t *testing.T) {
+	resourceName := "azurerm_storage_blob.source"
+	ri := tf.AccRandTimeInt()
+	rs1 := strings.ToLower(acctest.RandString(11))
+	sourceBlob, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+
+	writeSource := func() {
+		if err := sourceBlob.Truncate(0); err != nil {
+			t.Fatalf("Failed to truncate source blob")
+		}
+		if _, err := sourceBlob.Seek(0, 0); err != nil {
+			t.Fatalf("Failed to seek source blob")
+		}
+		if _, err := io.CopyN(sourceBlob, rand.Reader, 9*1024*1024); err != nil {
+			t.Fatalf("Failed to write random test to source blob")
+		}
+	}
+	writeSource()
+
+	config := testAccAzureRMStorageBlobBlock_source(ri, rs1, sourceBlob.Name(), testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobMatchesFile(resourceName, storage.BlobTypeBlock, sourceBlob.Name()),
+					testCheckAzureRMStorageBlobContentMD5MatchesFile(resourceName, sourceBlob.Name()),
+				),
+			},
+			{
+				PreConfig: writeSource,
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobMatchesFile(resourceName, storage.BlobTypeBlock, sourceBlob.Name()),
+					testCheckAzureRMStorageBlobContentMD5MatchesFile(resourceName, sourceBlob.Name()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageBlobBlock_sourceContent(t *testing.T) {
+	resourceName := "azurerm_storage_blob.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageBlobBlock_sourceContent(ri, rs, location, "Hello, World!"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", "65a8e27d8879283831b664bd8b7f0ad4"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageBlobBlock_sourceContent(ri, rs, location, "Goodbye, World!"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", "f9f6239b4838b415083e81a29cbd312e"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMStorageBlobExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -420,6 +765,17 @@ func testCheckAzureRMStorageBlobMatchesFile(resourceName string, kind storage.Bl
 	}
 }
 
+func testCheckAzureRMStorageBlobContentMD5MatchesFile(resourceName string, filePath string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		expected, err := resourceArmStorageBlobFileMD5(filePath)
+		if err != nil {
+			return err
+		}
+
+		return resource.TestCheckResourceAttr(resourceName, "content_md5", expected)(s)
+	}
+}
+
 func testCheckAzureRMStorageBlobDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "azurerm_storage_blob" {
@@ -692,3 +1048,132 @@ resource "azurerm_storage_blob" "source" {
 }
 `, rInt, location, rString, sourceBlobName, contentType)
 }
+
+func testAccAzureRMStorageBlobBlock_sourceContent(rInt int, rString, location string, content string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "content"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name = "example.txt"
+
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  storage_account_name   = "${azurerm_storage_account.test.name}"
+  storage_container_name = "${azurerm_storage_container.test.name}"
+
+  type           = "block"
+  source_content = "%s"
+  content_type   = "text/plain"
+
+  metadata = {
+    hello = "world"
+  }
+}
+`, rInt, location, rString, content)
+}
+
+func testAccAzureRMStorageBlob_sourceUriFromOtherAccount(rInt int, rString, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "source" {
+  name                     = "acctestsrc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "source" {
+  name                  = "source"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.source.name}"
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "source" {
+  name                   = "source.txt"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  storage_account_name   = "${azurerm_storage_account.source.name}"
+  storage_container_name = "${azurerm_storage_container.source.name}"
+  type                   = "block"
+  source_content         = "Hello, World!"
+}
+
+data "azurerm_storage_account_sas" "source" {
+  connection_string = "${azurerm_storage_account.source.primary_connection_string}"
+  https_only        = true
+
+  resource_types {
+    service   = false
+    container = false
+    object    = true
+  }
+
+  services {
+    blob  = true
+    queue = false
+    table = false
+    file  = false
+  }
+
+  start  = "2019-01-01"
+  expiry = "2099-01-01"
+
+  permissions {
+    read    = true
+    write   = false
+    delete  = false
+    list    = false
+    add     = false
+    create  = false
+    update  = false
+    process = false
+  }
+}
+
+resource "azurerm_storage_account" "destination" {
+  name                     = "acctestdst%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "destination" {
+  name                  = "destination"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.destination.name}"
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "destination" {
+  name                   = "destination.txt"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  storage_account_name   = "${azurerm_storage_account.destination.name}"
+  storage_container_name = "${azurerm_storage_container.destination.name}"
+  source_uri             = "${azurerm_storage_blob.source.url}${data.azurerm_storage_account_sas.source.sas}"
+  copy_timeout           = "10m"
+}
+`, rInt, location, rString, rString)
+}
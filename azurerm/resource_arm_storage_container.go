@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"net/url"
-	"regexp"
 	"strings"
 	"time"
 
@@ -12,7 +11,9 @@ import (
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 )
 
 func resourceArmStorageContainer() *schema.Resource {
@@ -27,12 +28,19 @@ func resourceArmStorageContainer() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArmStorageContainerName,
+				ValidateFunc: naming.Validate("azurerm_storage_container"),
 			},
 			"resource_group_name": resourceGroupNameSchema(),
 			"storage_account_name": {
@@ -55,24 +63,7 @@ func resourceArmStorageContainer() *schema.Resource {
 	}
 }
 
-//Following the naming convention as laid out in the docs
-func validateArmStorageContainerName(v interface{}, k string) (warnings []string, errors []error) {
-	value := v.(string)
-	if !regexp.MustCompile(`^\$root$|^[0-9a-z-]+$`).MatchString(value) {
-		errors = append(errors, fmt.Errorf(
-			"only lowercase alphanumeric characters and hyphens allowed in %q: %q",
-			k, value))
-	}
-	if len(value) < 3 || len(value) > 63 {
-		errors = append(errors, fmt.Errorf(
-			"%q must be between 3 and 63 characters: %q", k, value))
-	}
-	if regexp.MustCompile(`^-`).MatchString(value) {
-		errors = append(errors, fmt.Errorf(
-			"%q cannot begin with a hyphen: %q", k, value))
-	}
-	return warnings, errors
-}
+// Following the naming convention as laid out in the docs
 
 func validateArmStorageContainerAccessType(v interface{}, _ string) (warnings []string, errors []error) {
 	value := strings.ToLower(v.(string))
@@ -90,7 +81,8 @@ func validateArmStorageContainerAccessType(v interface{}, _ string) (warnings []
 
 func resourceArmStorageContainerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(armClient.StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroupName := d.Get("resource_group_name").(string)
@@ -113,7 +105,7 @@ func resourceArmStorageContainerCreateUpdate(d *schema.ResourceData, meta interf
 
 	reference := blobClient.GetContainerReference(name)
 	id := fmt.Sprintf("https://%s.blob.%s/%s", storageAccountName, armClient.environment.StorageEndpointSuffix, name)
-	if requireResourcesToBeImported && d.IsNewResource() {
+	if meta.(*ArmClient).features.requireResourcesToBeImported && d.IsNewResource() {
 		exists, e := reference.Exists()
 		if e != nil {
 			return fmt.Errorf("Error checking if Storage Container %q exists (Account %q / Resource Group %q): %s", name, storageAccountName, resourceGroupName, e)
@@ -147,7 +139,8 @@ func resourceArmStorageContainerCreateUpdate(d *schema.ResourceData, meta interf
 // read the status of the storage container off Azure.
 func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForRead(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageContainerID(d.Id(), armClient.environment)
 	if err != nil {
@@ -235,7 +228,8 @@ func resourceArmStorageContainerRead(d *schema.ResourceData, meta interface{}) e
 // delete a storage container off Azure.
 func resourceArmStorageContainerDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForDelete(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageContainerID(d.Id(), armClient.environment)
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
@@ -42,7 +43,7 @@ func TestAccAzureRMStorageContainer_basic(t *testing.T) {
 }
 
 func TestAccAzureRMStorageContainer_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -305,7 +306,7 @@ func TestValidateArmStorageContainerName(t *testing.T) {
 		"$root",
 	}
 	for _, v := range validNames {
-		_, errors := validateArmStorageContainerName(v, "name")
+		_, errors := naming.Validate("azurerm_storage_container")(v, "name")
 		if len(errors) != 0 {
 			t.Fatalf("%q should be a valid Storage Container Name: %q", v, errors)
 		}
@@ -321,7 +322,7 @@ func TestValidateArmStorageContainerName(t *testing.T) {
 		strings.Repeat("w", 65),
 	}
 	for _, v := range invalidNames {
-		_, errors := validateArmStorageContainerName(v, "name")
+		_, errors := naming.Validate("azurerm_storage_container")(v, "name")
 		if len(errors) == 0 {
 			t.Fatalf("%q should be an invalid Storage Container Name", v)
 		}
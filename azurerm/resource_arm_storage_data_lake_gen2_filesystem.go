@@ -0,0 +1,227 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+)
+
+func resourceArmStorageDataLakeGen2FileSystem() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageDataLakeGen2FileSystemCreate,
+		Read:   resourceArmStorageDataLakeGen2FileSystemRead,
+		Update: resourceArmStorageDataLakeGen2FileSystemUpdate,
+		Delete: resourceArmStorageDataLakeGen2FileSystemDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: naming.Validate("azurerm_storage_data_lake_gen2_filesystem"),
+			},
+
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidateStorageAccountID,
+			},
+
+			"properties": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceArmStorageDataLakeGen2FileSystemCreate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx, cancel := timeouts.ForCreate(armClient.StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	accountId, err := resourceid.ParseStorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	client, accountExists, err := armClient.getDataLakeGen2ClientForStorageAccount(ctx, accountId.ResourceGroup, accountId.Name)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q was not found in Resource Group %q!", accountId.Name, accountId.ResourceGroup)
+	}
+
+	id := client.fileSystemID(name)
+
+	if armClient.features.requireResourcesToBeImported {
+		_, err := client.GetFileSystemProperties(ctx, name)
+		if err == nil {
+			return tf.ImportAsExistsError("azurerm_storage_data_lake_gen2_filesystem", id)
+		}
+		if !storageDataLakeGen2WasNotFound(err) {
+			return fmt.Errorf("Error checking for presence of existing File System %q (Storage Account %q / Resource Group %q): %s", name, accountId.Name, accountId.ResourceGroup, err)
+		}
+	}
+
+	log.Printf("[INFO] Creating File System %q in Storage Account %q.", name, accountId.Name)
+	properties := expandStorageDataLakeGen2FileSystemProperties(d.Get("properties").(map[string]interface{}))
+	if err := client.CreateFileSystem(ctx, name, properties); err != nil {
+		return fmt.Errorf("Error creating File System %q (Storage Account %q / Resource Group %q): %+v", name, accountId.Name, accountId.ResourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmStorageDataLakeGen2FileSystemRead(d, meta)
+}
+
+func resourceArmStorageDataLakeGen2FileSystemUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx, cancel := timeouts.ForUpdate(armClient.StopContext, d)
+	defer cancel()
+
+	id, err := parseStorageDataLakeGen2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	accountId, err := resourceid.ParseStorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	client, accountExists, err := armClient.getDataLakeGen2ClientForStorageAccount(ctx, accountId.ResourceGroup, accountId.Name)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q was not found in Resource Group %q!", accountId.Name, accountId.ResourceGroup)
+	}
+
+	if d.HasChange("properties") {
+		log.Printf("[INFO] Updating the Properties of File System %q in Storage Account %q.", id.FileSystemName, id.AccountName)
+		properties := expandStorageDataLakeGen2FileSystemProperties(d.Get("properties").(map[string]interface{}))
+		if err := client.SetFileSystemProperties(ctx, id.FileSystemName, properties); err != nil {
+			return fmt.Errorf("Error updating the Properties of File System %q (Storage Account %q / Resource Group %q): %+v", id.FileSystemName, accountId.Name, accountId.ResourceGroup, err)
+		}
+	}
+
+	return resourceArmStorageDataLakeGen2FileSystemRead(d, meta)
+}
+
+func resourceArmStorageDataLakeGen2FileSystemRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx, cancel := timeouts.ForRead(armClient.StopContext, d)
+	defer cancel()
+
+	id, err := parseStorageDataLakeGen2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup, err := determineResourceGroupForStorageAccount(id.AccountName, armClient)
+	if err != nil {
+		return err
+	}
+	if resourceGroup == nil {
+		log.Printf("[DEBUG] Unable to locate Resource Group for Storage Account %q - assuming removed & removing from state", id.AccountName)
+		d.SetId("")
+		return nil
+	}
+
+	client, accountExists, err := armClient.getDataLakeGen2ClientForStorageAccount(ctx, *resourceGroup, id.AccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[DEBUG] Storage Account %q doesn't exist - assuming removed & removing from state", id.AccountName)
+		d.SetId("")
+		return nil
+	}
+
+	properties, err := client.GetFileSystemProperties(ctx, id.FileSystemName)
+	if err != nil {
+		if storageDataLakeGen2WasNotFound(err) {
+			log.Printf("[DEBUG] File System %q was not found in Storage Account %q - assuming removed & removing from state", id.FileSystemName, id.AccountName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving File System %q (Storage Account %q / Resource Group %q): %+v", id.FileSystemName, id.AccountName, *resourceGroup, err)
+	}
+
+	d.Set("name", id.FileSystemName)
+	d.Set("storage_account_id", resourceid.NewStorageAccountID(armClient.subscriptionId, *resourceGroup, id.AccountName).ID())
+
+	if err := d.Set("properties", properties); err != nil {
+		return fmt.Errorf("Error setting `properties`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmStorageDataLakeGen2FileSystemDelete(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx, cancel := timeouts.ForDelete(armClient.StopContext, d)
+	defer cancel()
+
+	id, err := parseStorageDataLakeGen2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup, err := determineResourceGroupForStorageAccount(id.AccountName, armClient)
+	if err != nil {
+		return err
+	}
+	if resourceGroup == nil {
+		log.Printf("[DEBUG] Unable to locate Resource Group for Storage Account %q - assuming removed", id.AccountName)
+		return nil
+	}
+
+	client, accountExists, err := armClient.getDataLakeGen2ClientForStorageAccount(ctx, *resourceGroup, id.AccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[DEBUG] Storage Account %q doesn't exist - assuming removed", id.AccountName)
+		return nil
+	}
+
+	if err := client.DeleteFileSystem(ctx, id.FileSystemName); err != nil {
+		if !storageDataLakeGen2WasNotFound(err) {
+			return fmt.Errorf("Error deleting File System %q (Storage Account %q / Resource Group %q): %+v", id.FileSystemName, id.AccountName, *resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandStorageDataLakeGen2FileSystemProperties(input map[string]interface{}) map[string]string {
+	properties := make(map[string]string)
+	for k, v := range input {
+		properties[k] = v.(string)
+	}
+	return properties
+}
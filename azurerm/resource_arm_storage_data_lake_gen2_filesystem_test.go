@@ -0,0 +1,254 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMStorageDataLakeGen2FileSystem_basic(t *testing.T) {
+	resourceName := "azurerm_storage_data_lake_gen2_filesystem.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageDataLakeGen2FileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageDataLakeGen2FileSystem_basic(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageDataLakeGen2FileSystemExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "properties.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageDataLakeGen2FileSystem_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImportedFromEnvironment() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_storage_data_lake_gen2_filesystem.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageDataLakeGen2FileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageDataLakeGen2FileSystem_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageDataLakeGen2FileSystemExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMStorageDataLakeGen2FileSystem_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_storage_data_lake_gen2_filesystem"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageDataLakeGen2FileSystem_properties(t *testing.T) {
+	resourceName := "azurerm_storage_data_lake_gen2_filesystem.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageDataLakeGen2FileSystemDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageDataLakeGen2FileSystem_properties(ri, rs, location, "hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageDataLakeGen2FileSystemExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "properties.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "properties.key", "hello"),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageDataLakeGen2FileSystem_properties(ri, rs, location, "world"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageDataLakeGen2FileSystemExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "properties.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "properties.key", "world"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStorageDataLakeGen2FileSystemExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseStorageDataLakeGen2ID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client, err := testGetStorageDataLakeGen2Client(id.AccountName)
+		if err != nil {
+			return err
+		}
+		if client == nil {
+			return fmt.Errorf("Bad: Storage Account %q does not exist", id.AccountName)
+		}
+
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		if _, err := client.GetFileSystemProperties(ctx, id.FileSystemName); err != nil {
+			if storageDataLakeGen2WasNotFound(err) {
+				return fmt.Errorf("Bad: File System %q (Storage Account %q) does not exist", id.FileSystemName, id.AccountName)
+			}
+
+			return fmt.Errorf("Bad: Get on storageDataLakeGen2Client: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageDataLakeGen2FileSystemDestroy(s *terraform.State) error {
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_storage_data_lake_gen2_filesystem" {
+			continue
+		}
+
+		id, err := parseStorageDataLakeGen2ID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client, err := testGetStorageDataLakeGen2Client(id.AccountName)
+		if err != nil {
+			return err
+		}
+		if client == nil {
+			// the Storage Account has been deleted, so the File System has too
+			return nil
+		}
+
+		if _, err := client.GetFileSystemProperties(ctx, id.FileSystemName); err != nil {
+			if !storageDataLakeGen2WasNotFound(err) {
+				return err
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("File System %q still exists in Storage Account %q", id.FileSystemName, id.AccountName)
+	}
+
+	return nil
+}
+
+// testGetStorageDataLakeGen2Client returns a client for the Storage Account, or nil if it doesn't exist
+func testGetStorageDataLakeGen2Client(accountName string) (*storageDataLakeGen2Client, error) {
+	armClient := testAccProvider.Meta().(*ArmClient)
+
+	resourceGroup, err := determineResourceGroupForStorageAccount(accountName, armClient)
+	if err != nil {
+		return nil, err
+	}
+	if resourceGroup == nil {
+		return nil, nil
+	}
+
+	client, accountExists, err := armClient.getDataLakeGen2ClientForStorageAccount(armClient.StopContext, *resourceGroup, accountName)
+	if err != nil {
+		return nil, err
+	}
+	if !accountExists {
+		return nil, nil
+	}
+
+	return client, nil
+}
+
+func testAccAzureRMStorageDataLakeGen2FileSystem_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestdls%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  account_kind             = "StorageV2"
+  is_hns_enabled           = true
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageDataLakeGen2FileSystem_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageDataLakeGen2FileSystem_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_filesystem" "test" {
+  name               = "acctest-%d"
+  storage_account_id = "${azurerm_storage_account.test.id}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMStorageDataLakeGen2FileSystem_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageDataLakeGen2FileSystem_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_filesystem" "import" {
+  name               = "${azurerm_storage_data_lake_gen2_filesystem.test.name}"
+  storage_account_id = "${azurerm_storage_data_lake_gen2_filesystem.test.storage_account_id}"
+}
+`, template)
+}
+
+func testAccAzureRMStorageDataLakeGen2FileSystem_properties(rInt int, rString string, location string, value string) string {
+	template := testAccAzureRMStorageDataLakeGen2FileSystem_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_filesystem" "test" {
+  name               = "acctest-%d"
+  storage_account_id = "${azurerm_storage_account.test.id}"
+
+  properties = {
+    key = "%s"
+  }
+}
+`, template, rInt, value)
+}
@@ -0,0 +1,399 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+)
+
+func resourceArmStorageDataLakeGen2Path() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageDataLakeGen2PathCreate,
+		Read:   resourceArmStorageDataLakeGen2PathRead,
+		Update: resourceArmStorageDataLakeGen2PathUpdate,
+		Delete: resourceArmStorageDataLakeGen2PathDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateStorageDataLakeGen2PathName,
+			},
+
+			"filesystem_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidateStorageAccountID,
+			},
+
+			"resource": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"directory"}, false),
+			},
+
+			"owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"group": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"ace": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scope": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "access",
+							ValidateFunc: validation.StringInSlice([]string{"access", "default"}, false),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"user", "group", "mask", "other"}, false),
+						},
+						"id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"permissions": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[r-][w-][x-]$`), "must be in the format `rwx`, where `-` is used in place of a permission which isn't granted"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmStorageDataLakeGen2PathCreate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx, cancel := timeouts.ForCreate(armClient.StopContext, d)
+	defer cancel()
+
+	path := d.Get("path").(string)
+	fileSystemName := d.Get("filesystem_name").(string)
+	accountId, err := resourceid.ParseStorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	client, accountExists, err := armClient.getDataLakeGen2ClientForStorageAccount(ctx, accountId.ResourceGroup, accountId.Name)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q was not found in Resource Group %q!", accountId.Name, accountId.ResourceGroup)
+	}
+
+	id := client.pathID(fileSystemName, path)
+
+	if armClient.features.requireResourcesToBeImported {
+		_, err := client.GetPathResourceType(ctx, fileSystemName, path)
+		if err == nil {
+			return tf.ImportAsExistsError("azurerm_storage_data_lake_gen2_path", id)
+		}
+		if !storageDataLakeGen2WasNotFound(err) {
+			return fmt.Errorf("Error checking for presence of existing Path %q (File System %q / Storage Account %q / Resource Group %q): %s", path, fileSystemName, accountId.Name, accountId.ResourceGroup, err)
+		}
+	}
+
+	log.Printf("[INFO] Creating Path %q in File System %q (Storage Account %q).", path, fileSystemName, accountId.Name)
+	if err := client.CreatePath(ctx, fileSystemName, path, d.Get("resource").(string)); err != nil {
+		return fmt.Errorf("Error creating Path %q (File System %q / Storage Account %q / Resource Group %q): %+v", path, fileSystemName, accountId.Name, accountId.ResourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	accessControl, err := expandStorageDataLakeGen2PathAccessControl(d)
+	if err != nil {
+		return err
+	}
+	if accessControl.Owner != "" || accessControl.Group != "" || accessControl.ACL != "" {
+		if err := client.SetPathAccessControl(ctx, fileSystemName, path, *accessControl); err != nil {
+			return fmt.Errorf("Error setting the Access Control of Path %q (File System %q / Storage Account %q / Resource Group %q): %+v", path, fileSystemName, accountId.Name, accountId.ResourceGroup, err)
+		}
+	}
+
+	return resourceArmStorageDataLakeGen2PathRead(d, meta)
+}
+
+func resourceArmStorageDataLakeGen2PathUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx, cancel := timeouts.ForUpdate(armClient.StopContext, d)
+	defer cancel()
+
+	id, err := parseStorageDataLakeGen2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	accountId, err := resourceid.ParseStorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	client, accountExists, err := armClient.getDataLakeGen2ClientForStorageAccount(ctx, accountId.ResourceGroup, accountId.Name)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q was not found in Resource Group %q!", accountId.Name, accountId.ResourceGroup)
+	}
+
+	if d.HasChange("owner") || d.HasChange("group") || d.HasChange("ace") {
+		accessControl, err := expandStorageDataLakeGen2PathAccessControl(d)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[INFO] Updating the Access Control of Path %q in File System %q (Storage Account %q).", id.Path, id.FileSystemName, id.AccountName)
+		if err := client.SetPathAccessControl(ctx, id.FileSystemName, id.Path, *accessControl); err != nil {
+			return fmt.Errorf("Error updating the Access Control of Path %q (File System %q / Storage Account %q / Resource Group %q): %+v", id.Path, id.FileSystemName, accountId.Name, accountId.ResourceGroup, err)
+		}
+	}
+
+	return resourceArmStorageDataLakeGen2PathRead(d, meta)
+}
+
+func resourceArmStorageDataLakeGen2PathRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx, cancel := timeouts.ForRead(armClient.StopContext, d)
+	defer cancel()
+
+	id, err := parseStorageDataLakeGen2ID(d.Id())
+	if err != nil {
+		return err
+	}
+	if id.Path == "" {
+		return fmt.Errorf("Expected the ID %q to contain a Path within the File System", d.Id())
+	}
+
+	resourceGroup, err := determineResourceGroupForStorageAccount(id.AccountName, armClient)
+	if err != nil {
+		return err
+	}
+	if resourceGroup == nil {
+		log.Printf("[DEBUG] Unable to locate Resource Group for Storage Account %q - assuming removed & removing from state", id.AccountName)
+		d.SetId("")
+		return nil
+	}
+
+	client, accountExists, err := armClient.getDataLakeGen2ClientForStorageAccount(ctx, *resourceGroup, id.AccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[DEBUG] Storage Account %q doesn't exist - assuming removed & removing from state", id.AccountName)
+		d.SetId("")
+		return nil
+	}
+
+	resourceType, err := client.GetPathResourceType(ctx, id.FileSystemName, id.Path)
+	if err != nil {
+		if storageDataLakeGen2WasNotFound(err) {
+			log.Printf("[DEBUG] Path %q was not found in File System %q (Storage Account %q) - assuming removed & removing from state", id.Path, id.FileSystemName, id.AccountName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Path %q (File System %q / Storage Account %q / Resource Group %q): %+v", id.Path, id.FileSystemName, id.AccountName, *resourceGroup, err)
+	}
+
+	accessControl, err := client.GetPathAccessControl(ctx, id.FileSystemName, id.Path)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Access Control of Path %q (File System %q / Storage Account %q / Resource Group %q): %+v", id.Path, id.FileSystemName, id.AccountName, *resourceGroup, err)
+	}
+
+	d.Set("path", id.Path)
+	d.Set("filesystem_name", id.FileSystemName)
+	d.Set("storage_account_id", resourceid.NewStorageAccountID(armClient.subscriptionId, *resourceGroup, id.AccountName).ID())
+	d.Set("resource", resourceType)
+	d.Set("owner", accessControl.Owner)
+	d.Set("group", accessControl.Group)
+
+	ace, err := flattenStorageDataLakeGen2PathACL(accessControl.ACL)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("ace", ace); err != nil {
+		return fmt.Errorf("Error setting `ace`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmStorageDataLakeGen2PathDelete(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	ctx, cancel := timeouts.ForDelete(armClient.StopContext, d)
+	defer cancel()
+
+	id, err := parseStorageDataLakeGen2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup, err := determineResourceGroupForStorageAccount(id.AccountName, armClient)
+	if err != nil {
+		return err
+	}
+	if resourceGroup == nil {
+		log.Printf("[DEBUG] Unable to locate Resource Group for Storage Account %q - assuming removed", id.AccountName)
+		return nil
+	}
+
+	client, accountExists, err := armClient.getDataLakeGen2ClientForStorageAccount(ctx, *resourceGroup, id.AccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		log.Printf("[DEBUG] Storage Account %q doesn't exist - assuming removed", id.AccountName)
+		return nil
+	}
+
+	// directories can only be deleted once they're empty unless the delete is recursive
+	if err := client.DeletePath(ctx, id.FileSystemName, id.Path, true); err != nil {
+		if !storageDataLakeGen2WasNotFound(err) {
+			return fmt.Errorf("Error deleting Path %q (File System %q / Storage Account %q / Resource Group %q): %+v", id.Path, id.FileSystemName, id.AccountName, *resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func validateStorageDataLakeGen2PathName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if value == "" {
+		errors = append(errors, fmt.Errorf("%q cannot be an empty string", k))
+		return warnings, errors
+	}
+
+	if strings.HasPrefix(value, "/") || strings.HasSuffix(value, "/") {
+		errors = append(errors, fmt.Errorf("%q cannot begin or end with a `/`: %q", k, value))
+	}
+
+	if strings.Contains(value, "//") {
+		errors = append(errors, fmt.Errorf("%q cannot contain consecutive `/` characters: %q", k, value))
+	}
+
+	return warnings, errors
+}
+
+func expandStorageDataLakeGen2PathAccessControl(d *schema.ResourceData) (*storageDataLakeGen2AccessControl, error) {
+	accessControl := storageDataLakeGen2AccessControl{
+		Owner: d.Get("owner").(string),
+		Group: d.Get("group").(string),
+	}
+
+	// the ACL replaces the existing one, so it's only sent when entries are specified
+	if v, ok := d.GetOk("ace"); ok {
+		acl, err := expandStorageDataLakeGen2PathACL(v.(*schema.Set).List())
+		if err != nil {
+			return nil, err
+		}
+		accessControl.ACL = acl
+	}
+
+	return &accessControl, nil
+}
+
+// expandStorageDataLakeGen2PathACL formats the Access Control Entries as a POSIX Access Control List, where each
+// entry is in the format `[default:]type:[id]:permissions`
+func expandStorageDataLakeGen2PathACL(input []interface{}) (string, error) {
+	entries := make([]string, 0)
+
+	for _, v := range input {
+		ace := v.(map[string]interface{})
+
+		scope := ace["scope"].(string)
+		aceType := ace["type"].(string)
+		id := ace["id"].(string)
+		permissions := ace["permissions"].(string)
+
+		if id != "" && aceType != "user" && aceType != "group" {
+			return "", fmt.Errorf("An `id` can only be specified for an `ace` with a `type` of `user` or `group` - got %q", aceType)
+		}
+
+		entry := fmt.Sprintf("%s:%s:%s", aceType, id, permissions)
+		if scope == "default" {
+			entry = fmt.Sprintf("default:%s", entry)
+		}
+		entries = append(entries, entry)
+	}
+
+	return strings.Join(entries, ","), nil
+}
+
+func flattenStorageDataLakeGen2PathACL(input string) ([]interface{}, error) {
+	output := make([]interface{}, 0)
+	if input == "" {
+		return output, nil
+	}
+
+	for _, entry := range strings.Split(input, ",") {
+		scope := "access"
+		value := entry
+		if strings.HasPrefix(value, "default:") {
+			scope = "default"
+			value = strings.TrimPrefix(value, "default:")
+		}
+
+		segments := strings.Split(value, ":")
+		if len(segments) != 3 {
+			return nil, fmt.Errorf("Expected the Access Control Entry %q to be in the format `[default:]type:[id]:permissions`", entry)
+		}
+
+		output = append(output, map[string]interface{}{
+			"scope":       scope,
+			"type":        segments[0],
+			"id":          segments[1],
+			"permissions": segments[2],
+		})
+	}
+
+	return output, nil
+}
@@ -0,0 +1,373 @@
+package azurerm
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestValidateStorageDataLakeGen2PathName(t *testing.T) {
+	testData := []struct {
+		Name  string
+		Input string
+		Valid bool
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Valid: false,
+		},
+		{
+			Name:  "Directory",
+			Input: "directory",
+			Valid: true,
+		},
+		{
+			Name:  "Nested Directory",
+			Input: "some/nested/directory",
+			Valid: true,
+		},
+		{
+			Name:  "Leading Slash",
+			Input: "/directory",
+			Valid: false,
+		},
+		{
+			Name:  "Trailing Slash",
+			Input: "directory/",
+			Valid: false,
+		},
+		{
+			Name:  "Consecutive Slashes",
+			Input: "some//directory",
+			Valid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		_, errors := validateStorageDataLakeGen2PathName(v.Input, "path")
+		if valid := len(errors) == 0; valid != v.Valid {
+			t.Fatalf("Expected %t but got %t: %+v", v.Valid, valid, errors)
+		}
+	}
+}
+
+func TestStorageDataLakeGen2PathACL_roundTrip(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"scope":       "access",
+			"type":        "user",
+			"id":          "",
+			"permissions": "rwx",
+		},
+		map[string]interface{}{
+			"scope":       "access",
+			"type":        "user",
+			"id":          "00000000-0000-0000-0000-000000000000",
+			"permissions": "r-x",
+		},
+		map[string]interface{}{
+			"scope":       "access",
+			"type":        "mask",
+			"id":          "",
+			"permissions": "r-x",
+		},
+		map[string]interface{}{
+			"scope":       "default",
+			"type":        "other",
+			"id":          "",
+			"permissions": "---",
+		},
+	}
+
+	acl, err := expandStorageDataLakeGen2PathACL(input)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	expected := "user::rwx,user:00000000-0000-0000-0000-000000000000:r-x,mask::r-x,default:other::---"
+	if acl != expected {
+		t.Fatalf("Expected the ACL to be %q but got %q", expected, acl)
+	}
+
+	output, err := flattenStorageDataLakeGen2PathACL(acl)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if !reflect.DeepEqual(input, output) {
+		t.Fatalf("Expected the ACL to round-trip to %+v but got %+v", input, output)
+	}
+}
+
+func TestStorageDataLakeGen2PathACL_invalid(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"scope":       "access",
+			"type":        "other",
+			"id":          "00000000-0000-0000-0000-000000000000",
+			"permissions": "r--",
+		},
+	}
+	if _, err := expandStorageDataLakeGen2PathACL(input); err == nil {
+		t.Fatalf("Expected an error specifying an `id` for an `ace` of type `other` but didn't get one")
+	}
+
+	if _, err := flattenStorageDataLakeGen2PathACL("user:rwx"); err == nil {
+		t.Fatalf("Expected an error parsing an invalid Access Control Entry but didn't get one")
+	}
+}
+
+func TestAccAzureRMStorageDataLakeGen2Path_basic(t *testing.T) {
+	resourceName := "azurerm_storage_data_lake_gen2_path.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageDataLakeGen2PathDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageDataLakeGen2Path_basic(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageDataLakeGen2PathExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource", "directory"),
+					resource.TestCheckResourceAttrSet(resourceName, "owner"),
+					resource.TestCheckResourceAttrSet(resourceName, "group"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageDataLakeGen2Path_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImportedFromEnvironment() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_storage_data_lake_gen2_path.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageDataLakeGen2PathDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageDataLakeGen2Path_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageDataLakeGen2PathExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMStorageDataLakeGen2Path_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_storage_data_lake_gen2_path"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageDataLakeGen2Path_withACL(t *testing.T) {
+	resourceName := "azurerm_storage_data_lake_gen2_path.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageDataLakeGen2PathDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageDataLakeGen2Path_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageDataLakeGen2PathExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageDataLakeGen2Path_withACL(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageDataLakeGen2PathExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ace.#", "8"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStorageDataLakeGen2PathExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseStorageDataLakeGen2ID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client, err := testGetStorageDataLakeGen2Client(id.AccountName)
+		if err != nil {
+			return err
+		}
+		if client == nil {
+			return fmt.Errorf("Bad: Storage Account %q does not exist", id.AccountName)
+		}
+
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		if _, err := client.GetPathResourceType(ctx, id.FileSystemName, id.Path); err != nil {
+			if storageDataLakeGen2WasNotFound(err) {
+				return fmt.Errorf("Bad: Path %q (File System %q / Storage Account %q) does not exist", id.Path, id.FileSystemName, id.AccountName)
+			}
+
+			return fmt.Errorf("Bad: Get on storageDataLakeGen2Client: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageDataLakeGen2PathDestroy(s *terraform.State) error {
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_storage_data_lake_gen2_path" {
+			continue
+		}
+
+		id, err := parseStorageDataLakeGen2ID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client, err := testGetStorageDataLakeGen2Client(id.AccountName)
+		if err != nil {
+			return err
+		}
+		if client == nil {
+			// the Storage Account has been deleted, so the Path has too
+			return nil
+		}
+
+		if _, err := client.GetPathResourceType(ctx, id.FileSystemName, id.Path); err != nil {
+			if !storageDataLakeGen2WasNotFound(err) {
+				return err
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("Path %q still exists in File System %q (Storage Account %q)", id.Path, id.FileSystemName, id.AccountName)
+	}
+
+	return nil
+}
+
+func testAccAzureRMStorageDataLakeGen2Path_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageDataLakeGen2FileSystem_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_path" "test" {
+  path               = "testpath/nested"
+  filesystem_name    = "${azurerm_storage_data_lake_gen2_filesystem.test.name}"
+  storage_account_id = "${azurerm_storage_account.test.id}"
+  resource           = "directory"
+}
+`, template)
+}
+
+func testAccAzureRMStorageDataLakeGen2Path_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageDataLakeGen2Path_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_path" "import" {
+  path               = "${azurerm_storage_data_lake_gen2_path.test.path}"
+  filesystem_name    = "${azurerm_storage_data_lake_gen2_path.test.filesystem_name}"
+  storage_account_id = "${azurerm_storage_data_lake_gen2_path.test.storage_account_id}"
+  resource           = "${azurerm_storage_data_lake_gen2_path.test.resource}"
+}
+`, template)
+}
+
+func testAccAzureRMStorageDataLakeGen2Path_withACL(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageDataLakeGen2FileSystem_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_storage_data_lake_gen2_path" "test" {
+  path               = "testpath/nested"
+  filesystem_name    = "${azurerm_storage_data_lake_gen2_filesystem.test.name}"
+  storage_account_id = "${azurerm_storage_account.test.id}"
+  resource           = "directory"
+
+  ace {
+    type        = "user"
+    permissions = "rwx"
+  }
+
+  ace {
+    type        = "user"
+    id          = "${data.azurerm_client_config.current.service_principal_object_id}"
+    permissions = "r-x"
+  }
+
+  ace {
+    type        = "group"
+    permissions = "r-x"
+  }
+
+  ace {
+    type        = "mask"
+    permissions = "r-x"
+  }
+
+  ace {
+    type        = "other"
+    permissions = "---"
+  }
+
+  ace {
+    scope       = "default"
+    type        = "user"
+    permissions = "rwx"
+  }
+
+  ace {
+    scope       = "default"
+    type        = "group"
+    permissions = "r-x"
+  }
+
+  ace {
+    scope       = "default"
+    type        = "other"
+    permissions = "---"
+  }
+}
+`, template)
+}
@@ -0,0 +1,447 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	storageMgmt "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// storageManagementPolicyDaysNotSpecified is used as the default for the number of days within an action, since
+// zero days is a valid value
+const storageManagementPolicyDaysNotSpecified = -1
+
+func resourceArmStorageManagementPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageManagementPolicyCreateUpdate,
+		Read:   resourceArmStorageManagementPolicyRead,
+		Update: resourceArmStorageManagementPolicyCreateUpdate,
+		Delete: resourceArmStorageManagementPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"storage_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceid.ValidateStorageAccountID,
+			},
+
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9]+$`), "can only contain alphanumeric characters"),
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"filters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"prefix_match": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Set:      schema.HashString,
+									},
+
+									"blob_types": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{"blockBlob"}, false),
+										},
+										Set: schema.HashString,
+									},
+								},
+							},
+						},
+
+						"actions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"base_blob": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"tier_to_cool_after_days_since_modification_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Default:      storageManagementPolicyDaysNotSpecified,
+													ValidateFunc: validation.IntBetween(0, 99999),
+												},
+												"tier_to_archive_after_days_since_modification_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Default:      storageManagementPolicyDaysNotSpecified,
+													ValidateFunc: validation.IntBetween(0, 99999),
+												},
+												"delete_after_days_since_modification_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Default:      storageManagementPolicyDaysNotSpecified,
+													ValidateFunc: validation.IntBetween(0, 99999),
+												},
+											},
+										},
+									},
+
+									"snapshot": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delete_after_days_since_creation_greater_than": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Default:      storageManagementPolicyDaysNotSpecified,
+													ValidateFunc: validation.IntBetween(0, 99999),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmStorageManagementPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageManagementPoliciesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	accountId, err := resourceid.ParseStorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// a Storage Account has a single Management Policy, so it's identified by the ID of the Storage Account
+	id := accountId.ID()
+
+	if d.IsNewResource() && meta.(*ArmClient).features.requireResourcesToBeImported {
+		existing, err := client.Get(ctx, accountId.ResourceGroup, accountId.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Management Policy (Storage Account %q / Resource Group %q): %s", accountId.Name, accountId.ResourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_storage_management_policy", id)
+		}
+	}
+
+	parameters := storageMgmt.ManagementPolicy{
+		ManagementPolicyProperties: &storageMgmt.ManagementPolicyProperties{
+			Policy: &storageMgmt.ManagementPolicySchema{
+				Rules: expandStorageManagementPolicyRules(d.Get("rule").([]interface{})),
+			},
+		},
+	}
+
+	if _, err := createOrUpdateStorageManagementPolicy(ctx, client, accountId.ResourceGroup, accountId.Name, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Management Policy (Storage Account %q / Resource Group %q): %+v", accountId.Name, accountId.ResourceGroup, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArmStorageManagementPolicyRead(d, meta)
+}
+
+func resourceArmStorageManagementPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageManagementPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseStorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Management Policy was not found for Storage Account %q / Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Management Policy (Storage Account %q / Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("storage_account_id", id.ID())
+
+	var rules *[]storageMgmt.ManagementPolicyRule
+	if props := resp.ManagementPolicyProperties; props != nil && props.Policy != nil {
+		rules = props.Policy.Rules
+	}
+	if err := d.Set("rule", flattenStorageManagementPolicyRules(rules)); err != nil {
+		return fmt.Errorf("Error setting `rule`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmStorageManagementPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageManagementPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseStorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, id.ResourceGroup, id.Name); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Management Policy (Storage Account %q / Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+// createOrUpdateStorageManagementPolicy creates or updates the Management Policy for a Storage Account. The SDK
+// treats the `properties` of a Management Policy as read-only and omits them from the request, so the body is
+// replaced with one including them before the request is sent
+func createOrUpdateStorageManagementPolicy(ctx context.Context, client storageMgmt.ManagementPoliciesClient, resourceGroupName, accountName string, parameters storageMgmt.ManagementPolicy) (storageMgmt.ManagementPolicy, error) {
+	req, err := client.CreateOrUpdatePreparer(ctx, resourceGroupName, accountName, parameters)
+	if err != nil {
+		return storageMgmt.ManagementPolicy{}, autorest.NewErrorWithError(err, "storage.ManagementPoliciesClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	body := struct {
+		Properties *storageMgmt.ManagementPolicyProperties `json:"properties,omitempty"`
+	}{
+		Properties: parameters.ManagementPolicyProperties,
+	}
+	req, err = autorest.Prepare(req, autorest.WithJSON(body))
+	if err != nil {
+		return storageMgmt.ManagementPolicy{}, autorest.NewErrorWithError(err, "storage.ManagementPoliciesClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := client.CreateOrUpdateSender(req)
+	if err != nil {
+		return storageMgmt.ManagementPolicy{Response: autorest.Response{Response: resp}}, autorest.NewErrorWithError(err, "storage.ManagementPoliciesClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	result, err := client.CreateOrUpdateResponder(resp)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "storage.ManagementPoliciesClient", "CreateOrUpdate", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+func expandStorageManagementPolicyRules(input []interface{}) *[]storageMgmt.ManagementPolicyRule {
+	rules := make([]storageMgmt.ManagementPolicyRule, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		rules = append(rules, storageMgmt.ManagementPolicyRule{
+			Name:    utils.String(v["name"].(string)),
+			Enabled: utils.Bool(v["enabled"].(bool)),
+			Type:    utils.String("Lifecycle"),
+			Definition: &storageMgmt.ManagementPolicyDefinition{
+				Filters: expandStorageManagementPolicyFilters(v["filters"].([]interface{})),
+				Actions: expandStorageManagementPolicyActions(v["actions"].([]interface{})),
+			},
+		})
+	}
+
+	return &rules
+}
+
+func expandStorageManagementPolicyFilters(input []interface{}) *storageMgmt.ManagementPolicyFilter {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	filters := storageMgmt.ManagementPolicyFilter{}
+
+	if prefixes := v["prefix_match"].(*schema.Set).List(); len(prefixes) > 0 {
+		filters.PrefixMatch = utils.ExpandStringArray(prefixes)
+	}
+	if blobTypes := v["blob_types"].(*schema.Set).List(); len(blobTypes) > 0 {
+		filters.BlobTypes = utils.ExpandStringArray(blobTypes)
+	}
+
+	return &filters
+}
+
+func expandStorageManagementPolicyActions(input []interface{}) *storageMgmt.ManagementPolicyAction {
+	actions := storageMgmt.ManagementPolicyAction{}
+	if len(input) == 0 || input[0] == nil {
+		return &actions
+	}
+
+	v := input[0].(map[string]interface{})
+
+	if baseBlobs := v["base_blob"].([]interface{}); len(baseBlobs) > 0 && baseBlobs[0] != nil {
+		baseBlob := baseBlobs[0].(map[string]interface{})
+		actions.BaseBlob = &storageMgmt.ManagementPolicyBaseBlob{
+			TierToCool:    expandStorageManagementPolicyDateAfterModification(baseBlob["tier_to_cool_after_days_since_modification_greater_than"].(int)),
+			TierToArchive: expandStorageManagementPolicyDateAfterModification(baseBlob["tier_to_archive_after_days_since_modification_greater_than"].(int)),
+			Delete:        expandStorageManagementPolicyDateAfterModification(baseBlob["delete_after_days_since_modification_greater_than"].(int)),
+		}
+	}
+
+	if snapshots := v["snapshot"].([]interface{}); len(snapshots) > 0 && snapshots[0] != nil {
+		snapshot := snapshots[0].(map[string]interface{})
+		actions.Snapshot = &storageMgmt.ManagementPolicySnapShot{}
+
+		if days := snapshot["delete_after_days_since_creation_greater_than"].(int); days != storageManagementPolicyDaysNotSpecified {
+			actions.Snapshot.Delete = &storageMgmt.DateAfterCreation{
+				DaysAfterCreationGreaterThan: utils.Int32(int32(days)),
+			}
+		}
+	}
+
+	return &actions
+}
+
+func expandStorageManagementPolicyDateAfterModification(days int) *storageMgmt.DateAfterModification {
+	if days == storageManagementPolicyDaysNotSpecified {
+		return nil
+	}
+
+	return &storageMgmt.DateAfterModification{
+		DaysAfterModificationGreaterThan: utils.Int32(int32(days)),
+	}
+}
+
+func flattenStorageManagementPolicyRules(input *[]storageMgmt.ManagementPolicyRule) []interface{} {
+	rules := make([]interface{}, 0)
+	if input == nil {
+		return rules
+	}
+
+	for _, rule := range *input {
+		name := ""
+		if rule.Name != nil {
+			name = *rule.Name
+		}
+
+		enabled := false
+		if rule.Enabled != nil {
+			enabled = *rule.Enabled
+		}
+
+		filters := make([]interface{}, 0)
+		actions := make([]interface{}, 0)
+		if definition := rule.Definition; definition != nil {
+			filters = flattenStorageManagementPolicyFilters(definition.Filters)
+			actions = flattenStorageManagementPolicyActions(definition.Actions)
+		}
+
+		rules = append(rules, map[string]interface{}{
+			"name":    name,
+			"enabled": enabled,
+			"filters": filters,
+			"actions": actions,
+		})
+	}
+
+	return rules
+}
+
+func flattenStorageManagementPolicyFilters(input *storageMgmt.ManagementPolicyFilter) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"prefix_match": schema.NewSet(schema.HashString, utils.FlattenStringArray(input.PrefixMatch)),
+			"blob_types":   schema.NewSet(schema.HashString, utils.FlattenStringArray(input.BlobTypes)),
+		},
+	}
+}
+
+func flattenStorageManagementPolicyActions(input *storageMgmt.ManagementPolicyAction) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	baseBlobs := make([]interface{}, 0)
+	if baseBlob := input.BaseBlob; baseBlob != nil {
+		baseBlobs = append(baseBlobs, map[string]interface{}{
+			"tier_to_cool_after_days_since_modification_greater_than":    flattenStorageManagementPolicyDateAfterModification(baseBlob.TierToCool),
+			"tier_to_archive_after_days_since_modification_greater_than": flattenStorageManagementPolicyDateAfterModification(baseBlob.TierToArchive),
+			"delete_after_days_since_modification_greater_than":          flattenStorageManagementPolicyDateAfterModification(baseBlob.Delete),
+		})
+	}
+
+	snapshots := make([]interface{}, 0)
+	if snapshot := input.Snapshot; snapshot != nil {
+		days := storageManagementPolicyDaysNotSpecified
+		if snapshot.Delete != nil && snapshot.Delete.DaysAfterCreationGreaterThan != nil {
+			days = int(*snapshot.Delete.DaysAfterCreationGreaterThan)
+		}
+
+		snapshots = append(snapshots, map[string]interface{}{
+			"delete_after_days_since_creation_greater_than": days,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"base_blob": baseBlobs,
+			"snapshot":  snapshots,
+		},
+	}
+}
+
+func flattenStorageManagementPolicyDateAfterModification(input *storageMgmt.DateAfterModification) int {
+	if input == nil || input.DaysAfterModificationGreaterThan == nil {
+		return storageManagementPolicyDaysNotSpecified
+	}
+
+	return int(*input.DaysAfterModificationGreaterThan)
+}
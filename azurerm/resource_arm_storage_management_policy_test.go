@@ -0,0 +1,409 @@
+package azurerm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	storageMgmt "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestCreateOrUpdateStorageManagementPolicy_sendsProperties(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Fatalf("Expected a PUT but got %q", r.Method)
+		}
+		if !strings.HasSuffix(r.URL.Path, "/storageAccounts/example/managementPolicies/default") {
+			t.Fatalf("Expected the Management Policy to be named `default` but got %q", r.URL.Path)
+		}
+
+		raw, _ := ioutil.ReadAll(r.Body)
+		if err := json.Unmarshal(raw, &body); err != nil {
+			t.Fatalf("Error parsing request body %q: %+v", string(raw), err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id":"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/example/managementPolicies/default"}`))
+	}))
+	defer server.Close()
+
+	client := storageMgmt.NewManagementPoliciesClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000")
+	parameters := storageMgmt.ManagementPolicy{
+		ManagementPolicyProperties: &storageMgmt.ManagementPolicyProperties{
+			Policy: &storageMgmt.ManagementPolicySchema{
+				Rules: &[]storageMgmt.ManagementPolicyRule{
+					{
+						Name:    utils.String("rule1"),
+						Enabled: utils.Bool(true),
+						Type:    utils.String("Lifecycle"),
+					},
+				},
+			},
+		},
+	}
+
+	result, err := createOrUpdateStorageManagementPolicy(context.TODO(), client, "group1", "example", parameters)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if result.ID == nil {
+		t.Fatalf("Expected the response to be parsed")
+	}
+
+	properties, ok := body["properties"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("Expected the request to contain `properties` but got %+v", body)
+	}
+	rules := properties["policy"].(map[string]interface{})["rules"].([]interface{})
+	if len(rules) != 1 || rules[0].(map[string]interface{})["name"] != "rule1" {
+		t.Fatalf("Expected the request to contain `rule1` but got %+v", rules)
+	}
+}
+
+func TestStorageManagementPolicyRules_roundTrip(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"name":    "rule1",
+			"enabled": true,
+			"filters": []interface{}{
+				map[string]interface{}{
+					"prefix_match": schema.NewSet(schema.HashString, []interface{}{"container1/prefix1"}),
+					"blob_types":   schema.NewSet(schema.HashString, []interface{}{"blockBlob"}),
+				},
+			},
+			"actions": []interface{}{
+				map[string]interface{}{
+					"base_blob": []interface{}{
+						map[string]interface{}{
+							"tier_to_cool_after_days_since_modification_greater_than":    0,
+							"tier_to_archive_after_days_since_modification_greater_than": storageManagementPolicyDaysNotSpecified,
+							"delete_after_days_since_modification_greater_than":          100,
+						},
+					},
+					"snapshot": []interface{}{
+						map[string]interface{}{
+							"delete_after_days_since_creation_greater_than": 30,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expanded := expandStorageManagementPolicyRules(input)
+	baseBlob := (*expanded)[0].Definition.Actions.BaseBlob
+	if baseBlob.TierToCool == nil || *baseBlob.TierToCool.DaysAfterModificationGreaterThan != 0 {
+		t.Fatalf("Expected Tier To Cool to be set to 0 days but got %+v", baseBlob.TierToCool)
+	}
+	if baseBlob.TierToArchive != nil {
+		t.Fatalf("Expected Tier To Archive to be omitted but got %+v", baseBlob.TierToArchive)
+	}
+
+	flattened := flattenStorageManagementPolicyRules(expanded)
+	actualFilters := flattened[0].(map[string]interface{})["filters"].([]interface{})[0].(map[string]interface{})
+	expectedFilters := input[0].(map[string]interface{})["filters"].([]interface{})[0].(map[string]interface{})
+	for _, key := range []string{"prefix_match", "blob_types"} {
+		if !actualFilters[key].(*schema.Set).Equal(expectedFilters[key].(*schema.Set)) {
+			t.Fatalf("Expected %q to be %+v but got %+v", key, expectedFilters[key], actualFilters[key])
+		}
+	}
+
+	actualActions := flattened[0].(map[string]interface{})["actions"]
+	expectedActions := input[0].(map[string]interface{})["actions"]
+	if !reflect.DeepEqual(actualActions, expectedActions) {
+		t.Fatalf("Expected the actions to be %+v but got %+v", expectedActions, actualActions)
+	}
+}
+
+func TestAccAzureRMStorageManagementPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_storage_management_policy.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageManagementPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageManagementPolicy_basic(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageManagementPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.name", "rule1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.filters.0.prefix_match.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.filters.0.blob_types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.actions.0.base_blob.0.tier_to_cool_after_days_since_modification_greater_than", "10"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.actions.0.base_blob.0.tier_to_archive_after_days_since_modification_greater_than", "50"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.actions.0.base_blob.0.delete_after_days_since_modification_greater_than", "100"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.actions.0.snapshot.0.delete_after_days_since_creation_greater_than", "30"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageManagementPolicy_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImportedFromEnvironment() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_storage_management_policy.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageManagementPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageManagementPolicy_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageManagementPolicyExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMStorageManagementPolicy_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_storage_management_policy"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageManagementPolicy_update(t *testing.T) {
+	resourceName := "azurerm_storage_management_policy.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageManagementPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageManagementPolicy_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageManagementPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageManagementPolicy_multipleRules(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageManagementPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.name", "rule2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.actions.0.base_blob.0.tier_to_archive_after_days_since_modification_greater_than", "-1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.actions.0.base_blob.0.delete_after_days_since_modification_greater_than", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStorageManagementPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := resourceid.ParseStorageAccountID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).storageManagementPoliciesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Management Policy (Storage Account %q / Resource Group %q) does not exist", id.Name, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on storageManagementPoliciesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageManagementPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).storageManagementPoliciesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_storage_management_policy" {
+			continue
+		}
+
+		id, err := resourceid.ParseStorageAccountID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return err
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("Management Policy still exists for Storage Account %q (Resource Group %q)", id.Name, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMStorageManagementPolicy_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "unlikely23exst2acct%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  account_kind             = "StorageV2"
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMStorageManagementPolicy_basic(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageManagementPolicy_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_management_policy" "test" {
+  storage_account_id = "${azurerm_storage_account.test.id}"
+
+  rule {
+    name    = "rule1"
+    enabled = true
+
+    filters {
+      prefix_match = ["container1/prefix1"]
+      blob_types   = ["blockBlob"]
+    }
+
+    actions {
+      base_blob {
+        tier_to_cool_after_days_since_modification_greater_than    = 10
+        tier_to_archive_after_days_since_modification_greater_than = 50
+        delete_after_days_since_modification_greater_than          = 100
+      }
+
+      snapshot {
+        delete_after_days_since_creation_greater_than = 30
+      }
+    }
+  }
+}
+`, template)
+}
+
+func testAccAzureRMStorageManagementPolicy_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageManagementPolicy_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_management_policy" "import" {
+  storage_account_id = "${azurerm_storage_management_policy.test.storage_account_id}"
+
+  rule {
+    name    = "rule1"
+    enabled = true
+
+    actions {
+      snapshot {
+        delete_after_days_since_creation_greater_than = 30
+      }
+    }
+  }
+}
+`, template)
+}
+
+func testAccAzureRMStorageManagementPolicy_multipleRules(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageManagementPolicy_template(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_management_policy" "test" {
+  storage_account_id = "${azurerm_storage_account.test.id}"
+
+  rule {
+    name    = "rule1"
+    enabled = false
+
+    filters {
+      prefix_match = ["container1/prefix1"]
+      blob_types   = ["blockBlob"]
+    }
+
+    actions {
+      base_blob {
+        tier_to_cool_after_days_since_modification_greater_than    = 11
+        tier_to_archive_after_days_since_modification_greater_than = 51
+        delete_after_days_since_modification_greater_than          = 101
+      }
+    }
+  }
+
+  rule {
+    name    = "rule2"
+    enabled = true
+
+    filters {
+      prefix_match = ["logs/"]
+      blob_types   = ["blockBlob"]
+    }
+
+    actions {
+      base_blob {
+        tier_to_cool_after_days_since_modification_greater_than = 7
+        delete_after_days_since_modification_greater_than       = 0
+      }
+    }
+  }
+}
+`, template)
+}
@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"log"
 	"net/url"
-	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 )
 
 func resourceArmStorageQueue() *schema.Resource {
@@ -23,12 +25,18 @@ func resourceArmStorageQueue() *schema.Resource {
 		SchemaVersion: 1,
 		MigrateState:  resourceStorageQueueMigrateState,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArmStorageQueueName,
+				ValidateFunc: naming.Validate("azurerm_storage_queue"),
 			},
 			"resource_group_name": resourceGroupNameSchema(),
 			"storage_account_name": {
@@ -40,38 +48,10 @@ func resourceArmStorageQueue() *schema.Resource {
 	}
 }
 
-func validateArmStorageQueueName(v interface{}, k string) (warnings []string, errors []error) {
-	value := v.(string)
-
-	if !regexp.MustCompile(`^[a-z0-9-]+$`).MatchString(value) {
-		errors = append(errors, fmt.Errorf(
-			"only lowercase alphanumeric characters and hyphens allowed in %q", k))
-	}
-
-	if regexp.MustCompile(`^-`).MatchString(value) {
-		errors = append(errors, fmt.Errorf("%q cannot start with a hyphen", k))
-	}
-
-	if regexp.MustCompile(`-$`).MatchString(value) {
-		errors = append(errors, fmt.Errorf("%q cannot end with a hyphen", k))
-	}
-
-	if len(value) > 63 {
-		errors = append(errors, fmt.Errorf(
-			"%q cannot be longer than 63 characters", k))
-	}
-
-	if len(value) < 3 {
-		errors = append(errors, fmt.Errorf(
-			"%q must be at least 3 characters", k))
-	}
-
-	return warnings, errors
-}
-
 func resourceArmStorageQueueCreate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForCreate(armClient.StopContext, d)
+	defer cancel()
 	environment := armClient.environment
 
 	name := d.Get("name").(string)
@@ -88,7 +68,7 @@ func resourceArmStorageQueueCreate(d *schema.ResourceData, meta interface{}) err
 
 	queueReference := queueClient.GetQueueReference(name)
 	id := fmt.Sprintf("https://%s.queue.%s/%s", storageAccountName, environment.StorageEndpointSuffix, name)
-	if requireResourcesToBeImported {
+	if meta.(*ArmClient).features.requireResourcesToBeImported {
 		exists, e := queueReference.Exists()
 		if e != nil {
 			return fmt.Errorf("Error checking if Queue %q exists (Account %q / Resource Group %q): %s", name, storageAccountName, resourceGroupName, e)
@@ -112,7 +92,8 @@ func resourceArmStorageQueueCreate(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmStorageQueueRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForRead(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageQueueID(d.Id())
 	if err != nil {
@@ -162,7 +143,8 @@ func resourceArmStorageQueueRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmStorageQueueDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForDelete(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageQueueID(d.Id())
 	if err != nil {
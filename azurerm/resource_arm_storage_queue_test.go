@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
@@ -43,7 +44,7 @@ func TestResourceAzureRMStorageQueueName_Validation(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		_, errors := validateArmStorageQueueName(tc.Value, "azurerm_storage_queue")
+		_, errors := naming.Validate("azurerm_storage_queue")(tc.Value, "azurerm_storage_queue")
 
 		if len(errors) != tc.ErrCount {
 			t.Fatalf("Expected the ARM Storage Queue Name to trigger a validation error")
@@ -78,7 +79,7 @@ func TestAccAzureRMStorageQueue_basic(t *testing.T) {
 }
 
 func TestAccAzureRMStorageQueue_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
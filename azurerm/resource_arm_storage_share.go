@@ -3,14 +3,16 @@ package azurerm
 import (
 	"fmt"
 	"log"
-	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 )
 
 func resourceArmStorageShare() *schema.Resource {
@@ -25,12 +27,19 @@ func resourceArmStorageShare() *schema.Resource {
 		SchemaVersion: 1,
 		MigrateState:  resourceStorageShareMigrateState,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArmStorageShareName,
+				ValidateFunc: naming.Validate("azurerm_storage_share"),
 			},
 			"resource_group_name": resourceGroupNameSchema(),
 			"storage_account_name": {
@@ -53,7 +62,8 @@ func resourceArmStorageShare() *schema.Resource {
 }
 func resourceArmStorageShareCreate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForCreate(armClient.StopContext, d)
+	defer cancel()
 
 	resourceGroupName := d.Get("resource_group_name").(string)
 	storageAccountName := d.Get("storage_account_name").(string)
@@ -74,7 +84,7 @@ func resourceArmStorageShareCreate(d *schema.ResourceData, meta interface{}) err
 	reference := fileClient.GetShareReference(name)
 
 	id := fmt.Sprintf("%s/%s/%s", name, resourceGroupName, storageAccountName)
-	if requireResourcesToBeImported {
+	if meta.(*ArmClient).features.requireResourcesToBeImported {
 		exists, e := reference.Exists()
 		if e != nil {
 			return fmt.Errorf("Error checking if Share %q exists (Account %q / Resource Group %q): %s", name, storageAccountName, resourceGroupName, e)
@@ -110,7 +120,8 @@ func resourceArmStorageShareCreate(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmStorageShareRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForRead(armClient.StopContext, d)
+	defer cancel()
 
 	id := strings.Split(d.Id(), "/")
 	if len(id) != 3 {
@@ -161,7 +172,8 @@ func resourceArmStorageShareRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmStorageShareUpdate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForUpdate(armClient.StopContext, d)
+	defer cancel()
 
 	id := strings.Split(d.Id(), "/")
 	if len(id) != 3 {
@@ -196,7 +208,8 @@ func resourceArmStorageShareUpdate(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmStorageShareDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForDelete(armClient.StopContext, d)
+	defer cancel()
 
 	id := strings.Split(d.Id(), "/")
 	if len(id) != 3 {
@@ -226,25 +239,4 @@ func resourceArmStorageShareDelete(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-//Following the naming convention as laid out in the docs https://msdn.microsoft.com/library/azure/dn167011.aspx
-func validateArmStorageShareName(v interface{}, k string) (warnings []string, errors []error) {
-	value := v.(string)
-	if !regexp.MustCompile(`^[0-9a-z-]+$`).MatchString(value) {
-		errors = append(errors, fmt.Errorf(
-			"only lowercase alphanumeric characters and hyphens allowed in %q: %q",
-			k, value))
-	}
-	if len(value) < 3 || len(value) > 63 {
-		errors = append(errors, fmt.Errorf(
-			"%q must be between 3 and 63 characters: %q", k, value))
-	}
-	if regexp.MustCompile(`^-`).MatchString(value) {
-		errors = append(errors, fmt.Errorf(
-			"%q cannot begin with a hyphen: %q", k, value))
-	}
-	if regexp.MustCompile(`[-]{2,}`).MatchString(value) {
-		errors = append(errors, fmt.Errorf(
-			"%q does not allow consecutive hyphens: %q", k, value))
-	}
-	return warnings, errors
-}
+// Following the naming convention as laid out in the docs https://msdn.microsoft.com/library/azure/dn167011.aspx
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
@@ -42,7 +43,7 @@ func TestAccAzureRMStorageShare_basic(t *testing.T) {
 }
 
 func TestAccAzureRMStorageShare_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -340,7 +341,7 @@ func TestValidateArmStorageShareName(t *testing.T) {
 		"valid02-name",
 	}
 	for _, v := range validNames {
-		_, errors := validateArmStorageShareName(v, "name")
+		_, errors := naming.Validate("azurerm_storage_share")(v, "name")
 		if len(errors) != 0 {
 			t.Fatalf("%q should be a valid Share Name: %q", v, errors)
 		}
@@ -356,7 +357,7 @@ func TestValidateArmStorageShareName(t *testing.T) {
 		strings.Repeat("w", 65),
 	}
 	for _, v := range invalidNames {
-		_, errors := validateArmStorageShareName(v, "name")
+		_, errors := naming.Validate("azurerm_storage_share")(v, "name")
 		if len(errors) == 0 {
 			t.Fatalf("%q should be an invalid Share Name", v)
 		}
@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"log"
 	"net/url"
-	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 )
 
 func resourceArmStorageTable() *schema.Resource {
@@ -23,12 +25,18 @@ func resourceArmStorageTable() *schema.Resource {
 		SchemaVersion: 1,
 		MigrateState:  resourceStorageTableMigrateState,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validateArmStorageTableName,
+				ValidateFunc: naming.Validate("azurerm_storage_table"),
 			},
 			"resource_group_name": resourceGroupNameSchema(),
 			"storage_account_name": {
@@ -40,25 +48,10 @@ func resourceArmStorageTable() *schema.Resource {
 	}
 }
 
-func validateArmStorageTableName(v interface{}, k string) (warnings []string, errors []error) {
-	value := v.(string)
-	if value == "table" {
-		errors = append(errors, fmt.Errorf(
-			"Table Storage %q cannot use the word `table`: %q",
-			k, value))
-	}
-	if !regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]{2,62}$`).MatchString(value) {
-		errors = append(errors, fmt.Errorf(
-			"Table Storage %q cannot begin with a numeric character, only alphanumeric characters are allowed and must be between 3 and 63 characters long: %q",
-			k, value))
-	}
-
-	return warnings, errors
-}
-
 func resourceArmStorageTableCreate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForCreate(armClient.StopContext, d)
+	defer cancel()
 	environment := armClient.environment
 
 	name := d.Get("name").(string)
@@ -76,7 +69,7 @@ func resourceArmStorageTableCreate(d *schema.ResourceData, meta interface{}) err
 	table := tableClient.GetTableReference(name)
 	id := fmt.Sprintf("https://%s.table.%s/%s", storageAccountName, environment.StorageEndpointSuffix, name)
 
-	if requireResourcesToBeImported {
+	if meta.(*ArmClient).features.requireResourcesToBeImported {
 		metaDataLevel := storage.MinimalMetadata
 		options := &storage.QueryTablesOptions{}
 		tables, e := tableClient.QueryTables(metaDataLevel, options)
@@ -105,7 +98,8 @@ func resourceArmStorageTableCreate(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmStorageTableRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForRead(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageTableID(d.Id())
 	if err != nil {
@@ -164,7 +158,8 @@ func resourceArmStorageTableRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmStorageTableDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForDelete(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageTableID(d.Id())
 	if err != nil {
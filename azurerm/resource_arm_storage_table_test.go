@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 )
 
@@ -42,7 +43,7 @@ func TestAccAzureRMStorageTable_basic(t *testing.T) {
 }
 
 func TestAccAzureRMStorageTable_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -234,7 +235,7 @@ func TestValidateArmStorageTableName(t *testing.T) {
 		strings.Repeat("w", 63),
 	}
 	for _, v := range validNames {
-		_, errors := validateArmStorageTableName(v, "name")
+		_, errors := naming.Validate("azurerm_storage_table")(v, "name")
 		if len(errors) != 0 {
 			t.Fatalf("%q should be a valid Storage Table Name: %q", v, errors)
 		}
@@ -249,7 +250,7 @@ func TestValidateArmStorageTableName(t *testing.T) {
 		strings.Repeat("w", 64),
 	}
 	for _, v := range invalidNames {
-		_, errors := validateArmStorageTableName(v, "name")
+		_, errors := naming.Validate("azurerm_storage_table")(v, "name")
 		if len(errors) == 0 {
 			t.Fatalf("%q should be an invalid Storage Table Name", v)
 		}
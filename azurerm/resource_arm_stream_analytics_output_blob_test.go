@@ -139,7 +139,7 @@ func TestAccAzureRMStreamAnalyticsOutputBlob_update(t *testing.T) {
 }
 
 func TestAccAzureRMStreamAnalyticsOutputBlob_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -171,7 +171,7 @@ func TestAccAzureRMStreamAnalyticsOutputEventHub_update(t *testing.T) {
 }
 
 func TestAccAzureRMStreamAnalyticsOutputEventHub_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -139,7 +139,7 @@ func TestAccAzureRMStreamAnalyticsStreamInputBlob_update(t *testing.T) {
 }
 
 func TestAccAzureRMStreamAnalyticsStreamInputBlob_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
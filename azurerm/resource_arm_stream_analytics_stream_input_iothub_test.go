@@ -133,7 +133,7 @@ func TestAccAzureRMStreamAnalyticsStreamInputIoTHub_update(t *testing.T) {
 }
 
 func TestAccAzureRMStreamAnalyticsStreamInputIoTHub_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -3,11 +3,15 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-12-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-02-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -23,11 +27,19 @@ func resourceArmSubnet() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: naming.Validate("azurerm_subnet"),
 			},
 
 			"resource_group_name": resourceGroupNameSchema(),
@@ -121,7 +133,8 @@ func resourceArmSubnet() *schema.Resource {
 
 func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).subnetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Subnet creation.")
 
@@ -129,7 +142,7 @@ func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) err
 	vnetName := d.Get("virtual_network_name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 
-	if requireResourcesToBeImported && d.IsNewResource() {
+	if meta.(*ArmClient).features.requireResourcesToBeImported && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, vnetName, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -157,10 +170,11 @@ func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) err
 			ID: &nsgId,
 		}
 
-		networkSecurityGroupName, err := parseNetworkSecurityGroupName(nsgId)
+		parsedNetworkSecurityGroupId, err := resourceid.ParseNetworkSecurityGroupID(nsgId)
 		if err != nil {
 			return err
 		}
+		networkSecurityGroupName := parsedNetworkSecurityGroupId.Name
 
 		azureRMLockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
 		defer azureRMUnlockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
@@ -174,10 +188,11 @@ func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) err
 			ID: &rtId,
 		}
 
-		routeTableName, err := parseRouteTableName(rtId)
+		parsedRouteTableId, err := resourceid.ParseRouteTableID(rtId)
 		if err != nil {
 			return err
 		}
+		routeTableName := parsedRouteTableId.Name
 
 		azureRMLockByName(routeTableName, routeTableResourceName)
 		defer azureRMUnlockByName(routeTableName, routeTableResourceName)
@@ -191,6 +206,19 @@ func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) err
 	delegations := expandSubnetDelegation(d)
 	properties.Delegations = &delegations
 
+	if !d.IsNewResource() {
+		// the NAT Gateway is managed via the `azurerm_subnet_nat_gateway_association` resource
+		// so we need to retain any existing association when updating the Subnet
+		existing, err := client.Get(ctx, resGroup, vnetName, name, "")
+		if err != nil {
+			return fmt.Errorf("Error retrieving existing Subnet %q (Virtual Network %q / Resource Group %q): %+v", name, vnetName, resGroup, err)
+		}
+
+		if props := existing.SubnetPropertiesFormat; props != nil {
+			properties.NatGateway = props.NatGateway
+		}
+	}
+
 	subnet := network.Subnet{
 		Name:                   &name,
 		SubnetPropertiesFormat: &properties,
@@ -220,15 +248,16 @@ func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).subnetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseSubnetID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	vnetName := id.Path["virtualNetworks"]
-	name := id.Path["subnets"]
+	vnetName := id.VirtualNetworkName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resGroup, vnetName, name, "")
 
@@ -280,22 +309,24 @@ func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).subnetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := resourceid.ParseSubnetID(d.Id())
 	if err != nil {
 		return err
 	}
 	resGroup := id.ResourceGroup
-	name := id.Path["subnets"]
-	vnetName := id.Path["virtualNetworks"]
+	name := id.Name
+	vnetName := id.VirtualNetworkName
 
 	if v, ok := d.GetOk("network_security_group_id"); ok {
 		networkSecurityGroupId := v.(string)
-		networkSecurityGroupName, err2 := parseNetworkSecurityGroupName(networkSecurityGroupId)
+		parsedNetworkSecurityGroupId, err2 := resourceid.ParseNetworkSecurityGroupID(networkSecurityGroupId)
 		if err2 != nil {
 			return err2
 		}
+		networkSecurityGroupName := parsedNetworkSecurityGroupId.Name
 
 		azureRMLockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
 		defer azureRMUnlockByName(networkSecurityGroupName, networkSecurityGroupResourceName)
@@ -303,10 +334,11 @@ func resourceArmSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 
 	if v, ok := d.GetOk("route_table_id"); ok {
 		rtId := v.(string)
-		routeTableName, err2 := parseRouteTableName(rtId)
+		parsedRouteTableId, err2 := resourceid.ParseRouteTableID(rtId)
 		if err2 != nil {
 			return err2
 		}
+		routeTableName := parsedRouteTableId.Name
 
 		azureRMLockByName(routeTableName, routeTableResourceName)
 		defer azureRMUnlockByName(routeTableName, routeTableResourceName)
@@ -29,7 +29,7 @@ func TestAccAzureRMTemplateDeployment_basic(t *testing.T) {
 	})
 }
 func TestAccAzureRMTemplateDeployment_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
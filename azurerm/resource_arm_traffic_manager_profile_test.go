@@ -45,7 +45,7 @@ func TestAccAzureRMTrafficManagerProfile_geographic(t *testing.T) {
 	})
 }
 func TestAccAzureRMTrafficManagerProfile_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 
@@ -13,8 +14,10 @@ import (
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -32,12 +35,19 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validate.NoEmptyStrings,
+				ValidateFunc: naming.Validate("azurerm_virtual_machine_scale_set"),
 			},
 
 			"location": locationSchema(),
@@ -178,6 +188,12 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 				DiffSuppressFunc: azureRmVirtualMachineScaleSetSuppressRollingUpgradePolicyDiff,
 			},
 
+			"upgrade_instances_on_model_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"overprovision": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -579,6 +595,8 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 							Type:     schema.TypeString,
 							Required: true,
 						},
+
+						"diff_disk_settings": diffDiskSettingsSchema(),
 					},
 				},
 				Set: resourceArmVirtualMachineScaleSetStorageProfileOsDiskHash,
@@ -754,14 +772,15 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 
 func resourceArmVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Virtual Machine Scale Set creation.")
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 
-	if requireResourcesToBeImported && d.IsNewResource() {
+	if meta.(*ArmClient).features.requireResourcesToBeImported && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -885,11 +904,19 @@ func resourceArmVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta
 	if err != nil {
 		return err
 	}
+	modelUpdatedAt := virtualMachineScaleSetModelUpdatedAt(future.Response(), time.Now())
 
 	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
 		return err
 	}
 
+	if !d.IsNewResource() && d.Get("upgrade_instances_on_model_change").(bool) {
+		imageChanged := d.HasChange("storage_profile_image_reference")
+		if err := upgradeVirtualMachineScaleSetInstances(ctx, meta, resGroup, name, upgradePolicy, imageChanged, modelUpdatedAt); err != nil {
+			return err
+		}
+	}
+
 	read, err := client.Get(ctx, resGroup, name)
 	if err != nil {
 		return err
@@ -905,7 +932,8 @@ func resourceArmVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta
 
 func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -951,6 +979,8 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 				}
 			}
 		}
+		// this isn't returned by the API, so it's set to the existing value (or the default when importing)
+		d.Set("upgrade_instances_on_model_change", d.Get("upgrade_instances_on_model_change").(bool))
 		d.Set("overprovision", properties.Overprovision)
 		d.Set("single_placement_group", properties.SinglePlacementGroup)
 
@@ -1060,7 +1090,8 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 
 func resourceArmVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -1401,6 +1432,7 @@ func flattenAzureRmVirtualMachineScaleSetStorageProfileOSDisk(profile *compute.V
 	result["caching"] = profile.Caching
 	result["create_option"] = profile.CreateOption
 	result["os_type"] = profile.OsType
+	result["diff_disk_settings"] = flattenDiffDiskSettings(profile.DiffDiskSettings)
 
 	return []interface{}{result}
 }
@@ -1533,6 +1565,13 @@ func resourceArmVirtualMachineScaleSetStorageProfileOsDiskHash(v interface{}) in
 		if v, ok := m["vhd_containers"]; ok {
 			buf.WriteString(fmt.Sprintf("%s-", v.(*schema.Set).List()))
 		}
+
+		// only included when set, so that the hash of existing OS Disks is unchanged
+		if v, ok := m["diff_disk_settings"].([]interface{}); ok {
+			if settings := expandDiffDiskSettings(v); settings != nil {
+				buf.WriteString(fmt.Sprintf("%s-", string(settings.Option)))
+			}
+		}
 	}
 
 	return hashcode.String(buf.String())
@@ -1938,6 +1977,11 @@ func expandAzureRMVirtualMachineScaleSetsStorageProfileOsDisk(d *schema.Resource
 	}
 	//END: code to be removed after GH-13016 is merged
 
+	osDisk.DiffDiskSettings = expandDiffDiskSettings(osDiskConfig["diff_disk_settings"].([]interface{}))
+	if err := validateDiffDiskSettings(osDisk.DiffDiskSettings, caching, osDisk.ManagedDisk != nil); err != nil {
+		return nil, err
+	}
+
 	return osDisk, nil
 }
 
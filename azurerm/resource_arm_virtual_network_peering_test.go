@@ -41,7 +41,7 @@ func TestAccAzureRMVirtualNetworkPeering_basic(t *testing.T) {
 }
 
 func TestAccAzureRMVirtualNetworkPeering_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -39,7 +39,7 @@ func TestAccAzureRMVirtualNetwork_basic(t *testing.T) {
 }
 
 func TestAccAzureRMVirtualNetwork_requiresImport(t *testing.T) {
-	if !requireResourcesToBeImported {
+	if !requireResourcesToBeImportedFromEnvironment() {
 		t.Skip("Skipping since resources aren't required to be imported")
 		return
 	}
@@ -0,0 +1,511 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-02-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/naming"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmWebApplicationFirewallPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmWebApplicationFirewallPolicyCreateUpdate,
+		Read:   resourceArmWebApplicationFirewallPolicyRead,
+		Update: resourceArmWebApplicationFirewallPolicyCreateUpdate,
+		Delete: resourceArmWebApplicationFirewallPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: naming.Validate("azurerm_web_application_firewall_policy"),
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"custom_rules": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"priority": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"rule_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(network.WebApplicationFirewallRuleTypeMatchRule),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.WebApplicationFirewallRuleTypeMatchRule),
+							}, false),
+						},
+
+						"action": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.WebApplicationFirewallActionAllow),
+								string(network.WebApplicationFirewallActionBlock),
+								string(network.WebApplicationFirewallActionLog),
+							}, false),
+						},
+
+						"match_conditions": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"match_variables": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"variable_name": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														string(network.PostArgs),
+														string(network.QueryString),
+														string(network.RemoteAddr),
+														string(network.RequestBody),
+														string(network.RequestCookies),
+														string(network.RequestHeaders),
+														string(network.RequestMethod),
+														string(network.RequestURI),
+													}, false),
+												},
+
+												"selector": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validate.NoEmptyStrings,
+												},
+											},
+										},
+									},
+
+									"operator": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(network.WebApplicationFirewallOperatorBeginsWith),
+											string(network.WebApplicationFirewallOperatorContains),
+											string(network.WebApplicationFirewallOperatorEndsWith),
+											string(network.WebApplicationFirewallOperatorEqual),
+											string(network.WebApplicationFirewallOperatorGreaterThan),
+											string(network.WebApplicationFirewallOperatorGreaterThanOrEqual),
+											string(network.WebApplicationFirewallOperatorIPMatch),
+											string(network.WebApplicationFirewallOperatorLessThan),
+											string(network.WebApplicationFirewallOperatorLessThanOrEqual),
+											string(network.WebApplicationFirewallOperatorRegex),
+										}, false),
+									},
+
+									"negation_condition": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+
+									"match_values": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validate.NoEmptyStrings,
+										},
+									},
+
+									"transforms": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(network.HTMLEntityDecode),
+												string(network.Lowercase),
+												string(network.RemoveNulls),
+												string(network.Trim),
+												string(network.URLDecode),
+												string(network.URLEncode),
+											}, false),
+										},
+										Set: schema.HashString,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"policy_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(network.WebApplicationFirewallModePrevention),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.WebApplicationFirewallModeDetection),
+								string(network.WebApplicationFirewallModePrevention),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmWebApplicationFirewallPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).wafPolicyClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for AzureRM Web Application Firewall Policy creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	if meta.(*ArmClient).features.requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, resGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Web Application Firewall Policy %q (Resource Group %q): %+v", name, resGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_web_application_firewall_policy", *existing.ID)
+		}
+	}
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	customRules := d.Get("custom_rules").([]interface{})
+	policySettings := d.Get("policy_settings").([]interface{})
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := network.WebApplicationFirewallPolicy{
+		Location: utils.String(location),
+		WebApplicationFirewallPolicyPropertiesFormat: &network.WebApplicationFirewallPolicyPropertiesFormat{
+			CustomRules:    expandArmWebApplicationFirewallPolicyCustomRules(customRules),
+			PolicySettings: expandArmWebApplicationFirewallPolicyPolicySettings(policySettings),
+		},
+		Tags: expandTags(tags),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resGroup, name, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Web Application Firewall Policy %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	read, err := client.Get(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Web Application Firewall Policy %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Web Application Firewall Policy %q (Resource Group %q) ID", name, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmWebApplicationFirewallPolicyRead(d, meta)
+}
+
+func resourceArmWebApplicationFirewallPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).wafPolicyClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseWebApplicationFirewallPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Web Application Firewall Policy %q (Resource Group %q) was not found - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Web Application Firewall Policy %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.WebApplicationFirewallPolicyPropertiesFormat; props != nil {
+		if err := d.Set("custom_rules", flattenArmWebApplicationFirewallPolicyCustomRules(props.CustomRules)); err != nil {
+			return fmt.Errorf("Error setting `custom_rules`: %+v", err)
+		}
+
+		if err := d.Set("policy_settings", flattenArmWebApplicationFirewallPolicyPolicySettings(props.PolicySettings)); err != nil {
+			return fmt.Errorf("Error setting `policy_settings`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmWebApplicationFirewallPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).wafPolicyClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := resourceid.ParseWebApplicationFirewallPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Web Application Firewall Policy %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Web Application Firewall Policy %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandArmWebApplicationFirewallPolicyCustomRules(input []interface{}) *[]network.WebApplicationFirewallCustomRule {
+	results := make([]network.WebApplicationFirewallCustomRule, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		rule := network.WebApplicationFirewallCustomRule{
+			Action:          network.WebApplicationFirewallAction(v["action"].(string)),
+			MatchConditions: expandArmWebApplicationFirewallPolicyMatchConditions(v["match_conditions"].([]interface{})),
+			Priority:        utils.Int32(int32(v["priority"].(int))),
+			RuleType:        network.WebApplicationFirewallRuleType(v["rule_type"].(string)),
+		}
+
+		if name := v["name"].(string); name != "" {
+			rule.Name = utils.String(name)
+		}
+
+		results = append(results, rule)
+	}
+
+	return &results
+}
+
+func expandArmWebApplicationFirewallPolicyMatchConditions(input []interface{}) *[]network.MatchCondition {
+	results := make([]network.MatchCondition, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		matchValues := make([]string, 0)
+		for _, value := range v["match_values"].([]interface{}) {
+			matchValues = append(matchValues, value.(string))
+		}
+
+		transforms := make([]network.WebApplicationFirewallTransform, 0)
+		for _, transform := range v["transforms"].(*schema.Set).List() {
+			transforms = append(transforms, network.WebApplicationFirewallTransform(transform.(string)))
+		}
+
+		results = append(results, network.MatchCondition{
+			MatchVariables:   expandArmWebApplicationFirewallPolicyMatchVariables(v["match_variables"].([]interface{})),
+			MatchValues:      &matchValues,
+			NegationConditon: utils.Bool(v["negation_condition"].(bool)),
+			Operator:         network.WebApplicationFirewallOperator(v["operator"].(string)),
+			Transforms:       &transforms,
+		})
+	}
+
+	return &results
+}
+
+func expandArmWebApplicationFirewallPolicyMatchVariables(input []interface{}) *[]network.MatchVariable {
+	results := make([]network.MatchVariable, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		variable := network.MatchVariable{
+			VariableName: network.WebApplicationFirewallMatchVariable(v["variable_name"].(string)),
+		}
+
+		if selector := v["selector"].(string); selector != "" {
+			variable.Selector = utils.String(selector)
+		}
+
+		results = append(results, variable)
+	}
+
+	return &results
+}
+
+func expandArmWebApplicationFirewallPolicyPolicySettings(input []interface{}) *network.PolicySettings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	enabledState := network.WebApplicationFirewallEnabledStateDisabled
+	if v["enabled"].(bool) {
+		enabledState = network.WebApplicationFirewallEnabledStateEnabled
+	}
+
+	return &network.PolicySettings{
+		EnabledState: enabledState,
+		Mode:         network.WebApplicationFirewallMode(v["mode"].(string)),
+	}
+}
+
+func flattenArmWebApplicationFirewallPolicyCustomRules(input *[]network.WebApplicationFirewallCustomRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		v := map[string]interface{}{
+			"action":           string(item.Action),
+			"match_conditions": flattenArmWebApplicationFirewallPolicyMatchConditions(item.MatchConditions),
+			"rule_type":        string(item.RuleType),
+		}
+
+		if name := item.Name; name != nil {
+			v["name"] = *name
+		}
+
+		if priority := item.Priority; priority != nil {
+			v["priority"] = int(*priority)
+		}
+
+		results = append(results, v)
+	}
+
+	return results
+}
+
+func flattenArmWebApplicationFirewallPolicyMatchConditions(input *[]network.MatchCondition) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		v := map[string]interface{}{
+			"match_variables": flattenArmWebApplicationFirewallPolicyMatchVariables(item.MatchVariables),
+			"operator":        string(item.Operator),
+		}
+
+		if negationCondition := item.NegationConditon; negationCondition != nil {
+			v["negation_condition"] = *negationCondition
+		}
+
+		matchValues := make([]interface{}, 0)
+		if item.MatchValues != nil {
+			for _, value := range *item.MatchValues {
+				matchValues = append(matchValues, value)
+			}
+		}
+		v["match_values"] = matchValues
+
+		transforms := make([]interface{}, 0)
+		if item.Transforms != nil {
+			for _, transform := range *item.Transforms {
+				transforms = append(transforms, string(transform))
+			}
+		}
+		v["transforms"] = schema.NewSet(schema.HashString, transforms)
+
+		results = append(results, v)
+	}
+
+	return results
+}
+
+func flattenArmWebApplicationFirewallPolicyMatchVariables(input *[]network.MatchVariable) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		v := map[string]interface{}{
+			"variable_name": string(item.VariableName),
+		}
+
+		if selector := item.Selector; selector != nil {
+			v["selector"] = *selector
+		}
+
+		results = append(results, v)
+	}
+
+	return results
+}
+
+func flattenArmWebApplicationFirewallPolicyPolicySettings(input *network.PolicySettings) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled": input.EnabledState == network.WebApplicationFirewallEnabledStateEnabled,
+			"mode":    string(input.Mode),
+		},
+	}
+}
@@ -0,0 +1,113 @@
+package azurerm
+
+import (
+	"context"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceProviderRegistrar registers the Resource Providers needed by a Resource the first time that
+// type of Resource is created, rather than registering every Resource Provider when the Provider is configured.
+//
+// Since a Service Principal may not have permission to register every Resource Provider (for example in a
+// locked-down Subscription) failing to register a Resource Provider is logged rather than returned - in which
+// case the error returned from the API when provisioning the Resource will explain what's wrong.
+type resourceProviderRegistrar struct {
+	client resources.ProvidersClient
+
+	lock sync.Mutex
+
+	// registrationStates is the Registration State (e.g. `Registered`) of each Resource Provider which is known
+	// to the registrar, keyed by the namespace in lower-case
+	registrationStates map[string]string
+
+	// attempted contains the lower-cased namespaces of the Resource Providers we've tried to register, so that
+	// registration is only attempted once per Resource Provider
+	attempted map[string]struct{}
+}
+
+func newResourceProviderRegistrar(client resources.ProvidersClient, availableRPs []resources.Provider) *resourceProviderRegistrar {
+	registrar := &resourceProviderRegistrar{
+		client:             client,
+		registrationStates: make(map[string]string),
+		attempted:          make(map[string]struct{}),
+	}
+
+	for _, provider := range availableRPs {
+		if provider.Namespace == nil || provider.RegistrationState == nil {
+			continue
+		}
+
+		registrar.registrationStates[strings.ToLower(*provider.Namespace)] = *provider.RegistrationState
+	}
+
+	return registrar
+}
+
+func (r *resourceProviderRegistrar) ensureRegistered(ctx context.Context, namespaces []string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, namespace := range namespaces {
+		key := strings.ToLower(namespace)
+		if strings.EqualFold(r.registrationStates[key], "Registered") {
+			continue
+		}
+
+		if _, ok := r.attempted[key]; ok {
+			continue
+		}
+		r.attempted[key] = struct{}{}
+
+		if _, ok := r.registrationStates[key]; !ok {
+			provider, err := r.client.Get(ctx, namespace, "")
+			if err != nil {
+				log.Printf("[WARN] Unable to retrieve the Registration State of the Resource Provider %q - attempting to register it: %+v", namespace, err)
+			} else if provider.RegistrationState != nil {
+				r.registrationStates[key] = *provider.RegistrationState
+				if strings.EqualFold(*provider.RegistrationState, "Registered") {
+					continue
+				}
+			}
+		}
+
+		log.Printf("[DEBUG] Registering the Resource Provider %q", namespace)
+		provider, err := r.client.Register(ctx, namespace)
+		if err != nil {
+			log.Printf("[WARN] Unable to register the Resource Provider %q - Resources which use it may fail to be provisioned: %+v", namespace, err)
+			continue
+		}
+
+		if provider.RegistrationState != nil {
+			r.registrationStates[key] = *provider.RegistrationState
+		}
+	}
+}
+
+// applyResourceProviderRegistration wraps the Create function of each Resource such that the Resource Providers
+// it needs are registered on-demand, when `lazy_provider_registration` is enabled
+func applyResourceProviderRegistration(p *schema.Provider) {
+	resourceProviders := resourceProvidersForResources()
+
+	for name, resource := range p.ResourcesMap {
+		namespaces := resourceProviders[name]
+		if len(namespaces) == 0 {
+			continue
+		}
+
+		if create := resource.Create; create != nil {
+			resource.Create = func(d *schema.ResourceData, meta interface{}) error {
+				client := meta.(*ArmClient)
+				if client.resourceProviderRegistrar != nil {
+					client.resourceProviderRegistrar.ensureRegistered(client.StopContext, namespaces)
+				}
+
+				return create(d, meta)
+			}
+		}
+	}
+}
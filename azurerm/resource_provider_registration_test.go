@@ -0,0 +1,78 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestResourceProviderRegistrar(t *testing.T) {
+	var lock sync.Mutex
+	requests := make([]string, 0)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		requests = append(requests, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+		lock.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		segments := strings.Split(r.URL.Path, "/")
+		namespace := segments[4]
+
+		// the principal isn't allowed to register the Scheduler Resource Provider
+		if namespace == "Microsoft.Scheduler" {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprintf(w, `{"error":{"code":"AuthorizationFailed","message":"not allowed to register %s"}}`, namespace)
+			return
+		}
+
+		state := "NotRegistered"
+		if r.Method == http.MethodPost {
+			state = "Registered"
+		}
+		fmt.Fprintf(w, `{"namespace":%q,"registrationState":%q}`, namespace, state)
+	}))
+	defer server.Close()
+
+	client := resources.NewProvidersClientWithBaseURI(server.URL, offlineSubscriptionId)
+	availableResourceProviders := []resources.Provider{
+		{
+			Namespace:         utils.String("Microsoft.Compute"),
+			RegistrationState: utils.String("Registered"),
+		},
+		{
+			Namespace:         utils.String("Microsoft.Scheduler"),
+			RegistrationState: utils.String("NotRegistered"),
+		},
+	}
+	registrar := newResourceProviderRegistrar(client, availableResourceProviders)
+
+	// registration is only attempted once per Resource Provider, regardless of whether it succeeds
+	for i := 0; i < 2; i++ {
+		registrar.ensureRegistered(context.Background(), []string{"Microsoft.Compute", "Microsoft.Scheduler", "Microsoft.Storage"})
+	}
+
+	prefix := fmt.Sprintf("/subscriptions/%s/providers", offlineSubscriptionId)
+	expected := []string{
+		fmt.Sprintf("GET %s/Microsoft.Storage", prefix),
+		fmt.Sprintf("POST %s/Microsoft.Scheduler/register", prefix),
+		fmt.Sprintf("POST %s/Microsoft.Storage/register", prefix),
+	}
+	sort.Strings(requests)
+	if !reflect.DeepEqual(requests, expected) {
+		t.Fatalf("Expected the requests %+v but got %+v", expected, requests)
+	}
+
+	if state := registrar.registrationStates["microsoft.storage"]; state != "Registered" {
+		t.Fatalf("Expected `Microsoft.Storage` to be `Registered` but got %q", state)
+	}
+}
@@ -1,8 +1,6 @@
 package azurerm
 
 import (
-	"fmt"
-
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 )
 
@@ -37,21 +35,3 @@ func parseAzureResourceID(id string) (*ResourceID, error) {
 		Path:           parsed.Path,
 	}, nil
 }
-
-func parseNetworkSecurityGroupName(networkSecurityGroupId string) (string, error) {
-	id, err := parseAzureResourceID(networkSecurityGroupId)
-	if err != nil {
-		return "", fmt.Errorf("[ERROR] Unable to Parse Network Security Group ID '%s': %+v", networkSecurityGroupId, err)
-	}
-
-	return id.Path["networkSecurityGroups"], nil
-}
-
-func parseRouteTableName(routeTableId string) (string, error) {
-	id, err := parseAzureResourceID(routeTableId)
-	if err != nil {
-		return "", fmt.Errorf("[ERROR] Unable to parse Route Table ID '%s': %+v", routeTableId, err)
-	}
-
-	return id.Path["routeTables"], nil
-}
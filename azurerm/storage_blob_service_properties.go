@@ -0,0 +1,190 @@
+package azurerm
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tracing"
+)
+
+// storageBlobServicePropertiesAPIVersion is the Storage API version used for Blob Service Properties, which is newer
+// than the version used by the Storage SDK since it's required for the Static Website and Delete Retention Policy
+const storageBlobServicePropertiesAPIVersion = "2018-03-28"
+
+// storageBlobServiceProperties are the properties of the Blob Service within a Storage Account - any which are nil
+// are left unchanged when the properties are set
+type storageBlobServiceProperties struct {
+	XMLName               xml.Name                      `xml:"StorageServiceProperties"`
+	Cors                  *mainStorage.Cors             `xml:"Cors,omitempty"`
+	DeleteRetentionPolicy *storageDeleteRetentionPolicy `xml:"DeleteRetentionPolicy,omitempty"`
+	StaticWebsite         *storageStaticWebsite         `xml:"StaticWebsite,omitempty"`
+}
+
+type storageDeleteRetentionPolicy struct {
+	Enabled bool `xml:"Enabled"`
+	Days    *int `xml:"Days,omitempty"`
+}
+
+type storageStaticWebsite struct {
+	Enabled              bool   `xml:"Enabled"`
+	IndexDocument        string `xml:"IndexDocument,omitempty"`
+	ErrorDocument404Path string `xml:"ErrorDocument404Path,omitempty"`
+}
+
+// storageServicePropertiesError is returned when the Storage API returns an error
+type storageServicePropertiesError struct {
+	StatusCode int
+	Code       string `xml:"Code"`
+	Message    string `xml:"Message"`
+}
+
+func (e storageServicePropertiesError) Error() string {
+	return fmt.Sprintf("storage: service returned error: StatusCode=%d, ErrorCode=%s, ErrorMessage=%s", e.StatusCode, e.Code, e.Message)
+}
+
+// storageBlobServicePropertiesClient retrieves and sets the properties of the Blob Service within a Storage Account,
+// authenticating using an Account SAS Token for the Service which is generated from the Storage Account Key
+type storageBlobServicePropertiesClient struct {
+	endpoint string
+	sasToken url.Values
+	sender   autorest.Sender
+}
+
+func (c *ArmClient) getBlobServicePropertiesClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*storageBlobServicePropertiesClient, bool, error) {
+	key, accountExists, err := c.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		return nil, accountExists, err
+	}
+	if !accountExists {
+		return nil, false, nil
+	}
+
+	storageClient, err := mainStorage.NewClient(storageAccountName, key, c.environment.StorageEndpointSuffix,
+		storageBlobServicePropertiesAPIVersion, true)
+	if err != nil {
+		return nil, true, fmt.Errorf("Error creating storage client for storage storeAccount %q: %s", storageAccountName, err)
+	}
+
+	sasToken, err := storageClient.GetAccountSASToken(mainStorage.AccountSASTokenOptions{
+		Services: mainStorage.Services{
+			Blob: true,
+		},
+		ResourceTypes: mainStorage.ResourceTypes{
+			Service: true,
+		},
+		Permissions: mainStorage.Permissions{
+			Read:  true,
+			Write: true,
+		},
+		Start:    time.Now().Add(-5 * time.Minute),
+		Expiry:   time.Now().Add(time.Hour),
+		UseHTTPS: true,
+	})
+	if err != nil {
+		return nil, true, fmt.Errorf("Error generating SAS Token for storage storeAccount %q: %s", storageAccountName, err)
+	}
+
+	sender := c.sender
+	if sender == nil {
+		sender = &http.Client{}
+	}
+
+	client := storageBlobServicePropertiesClient{
+		endpoint: fmt.Sprintf("https://%s.blob.%s", storageAccountName, c.environment.StorageEndpointSuffix),
+		sasToken: sasToken,
+		sender:   sender,
+	}
+	return &client, true, nil
+}
+
+func (c storageBlobServicePropertiesClient) GetServiceProperties(ctx context.Context) (*storageBlobServiceProperties, error) {
+	resp, err := c.send(ctx, http.MethodGet, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	var props storageBlobServiceProperties
+	if err := xml.Unmarshal(resp, &props); err != nil {
+		return nil, fmt.Errorf("Error parsing Blob Service Properties: %+v", err)
+	}
+
+	return &props, nil
+}
+
+func (c storageBlobServicePropertiesClient) SetServiceProperties(ctx context.Context, props storageBlobServiceProperties) error {
+	body, err := xml.Marshal(props)
+	if err != nil {
+		return fmt.Errorf("Error serializing Blob Service Properties: %+v", err)
+	}
+
+	_, err = c.send(ctx, http.MethodPut, body, http.StatusAccepted)
+	return err
+}
+
+func (c storageBlobServicePropertiesClient) send(ctx context.Context, method string, body []byte, expectedStatusCode int) ([]byte, error) {
+	query := url.Values{
+		"restype": {"service"},
+		"comp":    {"properties"},
+	}
+	for k, v := range c.sasToken {
+		query[k] = v
+	}
+
+	req, err := http.NewRequest(method, fmt.Sprintf("%s/?%s", c.endpoint, query.Encode()), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("x-ms-version", storageBlobServicePropertiesAPIVersion)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/xml")
+	}
+
+	resp, err := c.sender.Do(req)
+	if err != nil {
+		// the URL contains the SAS Token, which mustn't be surfaced in the error
+		return nil, tracing.RedactError(err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != expectedStatusCode {
+		// the body isn't always returned, in which case only the Status Code is available
+		serviceErr := storageServicePropertiesError{}
+		_ = xml.Unmarshal(respBody, &serviceErr)
+		serviceErr.StatusCode = resp.StatusCode
+		return nil, serviceErr
+	}
+
+	return respBody, nil
+}
+
+// storageServicePropertiesInaccessible returns whether the error is because the data plane of the Storage Account
+// can't be accessed - either because access is denied (for example due to the Firewall configured within the
+// `network_rules` block) or because it can't be reached (for example when DNS resolution or the connection fails
+// because the Storage Account is only reachable through a Private Endpoint, or outbound access is restricted)
+func storageServicePropertiesInaccessible(err error) bool {
+	switch v := err.(type) {
+	case storageServicePropertiesError:
+		return v.StatusCode == http.StatusForbidden
+	case mainStorage.AzureStorageServiceError:
+		return v.StatusCode == http.StatusForbidden
+	case *url.Error:
+		// a request which was cancelled (e.g. the timeout for the refresh was reached) is still an error
+		return v.Err != context.Canceled && v.Err != context.DeadlineExceeded
+	}
+
+	return false
+}
@@ -0,0 +1,227 @@
+package azurerm
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
+)
+
+func testStorageBlobServicePropertiesClient(t *testing.T, handler http.HandlerFunc) (*storageBlobServicePropertiesClient, func()) {
+	server := httptest.NewServer(handler)
+	client := &storageBlobServicePropertiesClient{
+		endpoint: server.URL,
+		sasToken: url.Values{
+			"sig": {"example"},
+		},
+		sender: server.Client(),
+	}
+	return client, server.Close
+}
+
+func TestStorageBlobServicePropertiesClient_get(t *testing.T) {
+	client, closeFunc := testStorageBlobServicePropertiesClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Fatalf("Expected a GET but got %q", r.Method)
+		}
+		if v := r.URL.Query().Get("comp"); v != "properties" {
+			t.Fatalf("Expected `comp` to be %q but got %q", "properties", v)
+		}
+		if v := r.URL.Query().Get("sig"); v != "example" {
+			t.Fatalf("Expected the SAS Token to be sent but got %q", v)
+		}
+		if v := r.Header.Get("x-ms-version"); v != storageBlobServicePropertiesAPIVersion {
+			t.Fatalf("Expected `x-ms-version` to be %q but got %q", storageBlobServicePropertiesAPIVersion, v)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<?xml version="1.0" encoding="utf-8"?>
+<StorageServiceProperties>
+  <Cors>
+    <CorsRule>
+      <AllowedMethods>GET,HEAD</AllowedMethods>
+      <AllowedOrigins>http://www.example.com</AllowedOrigins>
+      <AllowedHeaders>x-ms-meta-*</AllowedHeaders>
+      <ExposedHeaders>*</ExposedHeaders>
+      <MaxAgeInSeconds>3600</MaxAgeInSeconds>
+    </CorsRule>
+  </Cors>
+  <DeleteRetentionPolicy><Enabled>true</Enabled><Days>14</Days></DeleteRetentionPolicy>
+  <StaticWebsite><Enabled>true</Enabled><IndexDocument>index.html</IndexDocument><ErrorDocument404Path>404.html</ErrorDocument404Path></StaticWebsite>
+</StorageServiceProperties>`))
+	})
+	defer closeFunc()
+
+	props, err := client.GetServiceProperties(context.TODO())
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if props.Cors == nil || len(props.Cors.CorsRule) != 1 {
+		t.Fatalf("Expected 1 CORS Rule but got %+v", props.Cors)
+	}
+	if v := props.Cors.CorsRule[0].AllowedMethods; v != "GET,HEAD" {
+		t.Fatalf("Expected the Allowed Methods to be %q but got %q", "GET,HEAD", v)
+	}
+	if v := props.Cors.CorsRule[0].MaxAgeInSeconds; v != 3600 {
+		t.Fatalf("Expected the Max Age to be %d but got %d", 3600, v)
+	}
+	if props.DeleteRetentionPolicy == nil || !props.DeleteRetentionPolicy.Enabled || *props.DeleteRetentionPolicy.Days != 14 {
+		t.Fatalf("Expected the Delete Retention Policy to be enabled for 14 days but got %+v", props.DeleteRetentionPolicy)
+	}
+	if props.StaticWebsite == nil || props.StaticWebsite.IndexDocument != "index.html" || props.StaticWebsite.ErrorDocument404Path != "404.html" {
+		t.Fatalf("Expected the Static Website to be enabled but got %+v", props.StaticWebsite)
+	}
+}
+
+func TestStorageBlobServicePropertiesClient_setOnlySendsSpecifiedProperties(t *testing.T) {
+	var body string
+	client, closeFunc := testStorageBlobServicePropertiesClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Fatalf("Expected a PUT but got %q", r.Method)
+		}
+
+		raw, _ := ioutil.ReadAll(r.Body)
+		body = string(raw)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	defer closeFunc()
+
+	props := storageBlobServiceProperties{
+		StaticWebsite: &storageStaticWebsite{
+			Enabled:       true,
+			IndexDocument: "index.html",
+		},
+	}
+	if err := client.SetServiceProperties(context.TODO(), props); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	expected := "<StorageServiceProperties><StaticWebsite><Enabled>true</Enabled><IndexDocument>index.html</IndexDocument></StaticWebsite></StorageServiceProperties>"
+	if body != expected {
+		t.Fatalf("Expected the body to be %q but got %q", expected, body)
+	}
+}
+
+func TestStorageBlobServicePropertiesClient_setClearsCorsRules(t *testing.T) {
+	var body string
+	client, closeFunc := testStorageBlobServicePropertiesClient(t, func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := ioutil.ReadAll(r.Body)
+		body = string(raw)
+		w.WriteHeader(http.StatusAccepted)
+	})
+	defer closeFunc()
+
+	props := storageBlobServiceProperties{}
+	expandStorageAccountBlobProperties([]interface{}{}, &props)
+	if err := client.SetServiceProperties(context.TODO(), props); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	// an empty `Cors` element removes any existing CORS Rules, whereas omitting it leaves them unchanged
+	if !strings.Contains(body, "<Cors></Cors>") {
+		t.Fatalf("Expected an empty `Cors` element but got %q", body)
+	}
+	if !strings.Contains(body, "<DeleteRetentionPolicy><Enabled>false</Enabled></DeleteRetentionPolicy>") {
+		t.Fatalf("Expected the Delete Retention Policy to be disabled but got %q", body)
+	}
+	if strings.Contains(body, "StaticWebsite") {
+		t.Fatalf("Expected the Static Website to be left unchanged but got %q", body)
+	}
+}
+
+func TestStorageBlobServicePropertiesClient_error(t *testing.T) {
+	client, closeFunc := testStorageBlobServicePropertiesClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`<?xml version="1.0" encoding="utf-8"?><Error><Code>AuthorizationFailure</Code><Message>This request is not authorized to perform this operation.</Message></Error>`))
+	})
+	defer closeFunc()
+
+	_, err := client.GetServiceProperties(context.TODO())
+	if err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+
+	serviceErr, ok := err.(storageServicePropertiesError)
+	if !ok {
+		t.Fatalf("Expected a storageServicePropertiesError but got %T", err)
+	}
+	if serviceErr.Code != "AuthorizationFailure" {
+		t.Fatalf("Expected the Code to be %q but got %q", "AuthorizationFailure", serviceErr.Code)
+	}
+	if !storageServicePropertiesInaccessible(err) {
+		t.Fatalf("Expected the error to be treated as Inaccessible")
+	}
+}
+
+func TestStorageBlobServicePropertiesClient_unreachable(t *testing.T) {
+	client, closeFunc := testStorageBlobServicePropertiesClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("Expected no requests to reach the server")
+	})
+	// closing the server means the connection is refused, as if the Data Plane can't be reached
+	closeFunc()
+
+	_, err := client.GetServiceProperties(context.TODO())
+	if err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+	if strings.Contains(err.Error(), "sig=example") {
+		t.Fatalf("Expected the SAS Token to be redacted from the error but got %q", err.Error())
+	}
+	if !storageServicePropertiesInaccessible(err) {
+		t.Fatalf("Expected the error to be treated as Inaccessible but got %+v", err)
+	}
+}
+
+func TestStorageServicePropertiesInaccessible(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Error    error
+		Expected bool
+	}{
+		{
+			Name:     "Blob Forbidden",
+			Error:    storageServicePropertiesError{StatusCode: http.StatusForbidden},
+			Expected: true,
+		},
+		{
+			Name:     "Blob Internal Server Error",
+			Error:    storageServicePropertiesError{StatusCode: http.StatusInternalServerError},
+			Expected: false,
+		},
+		{
+			Name:     "Queue Forbidden",
+			Error:    mainStorage.AzureStorageServiceError{StatusCode: http.StatusForbidden},
+			Expected: true,
+		},
+		{
+			Name:     "DNS Resolution Failed",
+			Error:    &url.Error{Op: "Get", URL: "https://example.blob.core.windows.net/", Err: &net.DNSError{Err: "no such host", Name: "example.blob.core.windows.net"}},
+			Expected: true,
+		},
+		{
+			Name:     "Request Cancelled",
+			Error:    &url.Error{Op: "Get", URL: "https://example.blob.core.windows.net/", Err: context.DeadlineExceeded},
+			Expected: false,
+		},
+		{
+			Name:     "Other Error",
+			Error:    context.DeadlineExceeded,
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := storageServicePropertiesInaccessible(v.Error); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
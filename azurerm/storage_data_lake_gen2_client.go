@@ -0,0 +1,411 @@
+package azurerm
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// storageDataLakeGen2APIVersion is the version of the Data Lake Storage Gen2 (DFS) API, which isn't supported by the
+// Storage SDK
+const storageDataLakeGen2APIVersion = "2018-11-09"
+
+// storageDataLakeGen2Error is returned when the Data Lake Storage Gen2 API returns an error
+type storageDataLakeGen2Error struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e storageDataLakeGen2Error) Error() string {
+	return fmt.Sprintf("storage: service returned error: StatusCode=%d, ErrorCode=%s, ErrorMessage=%s", e.StatusCode, e.Code, e.Message)
+}
+
+// storageDataLakeGen2AccessControl is the Owner, Group and Access Control List of a Path within a File System
+type storageDataLakeGen2AccessControl struct {
+	Owner string
+	Group string
+	ACL   string
+}
+
+// storageDataLakeGen2Client manages File Systems and Paths within a Storage Account with a Hierarchical Namespace,
+// authenticating using Shared Key authorization with the Storage Account Key
+type storageDataLakeGen2Client struct {
+	endpoint    string
+	accountName string
+	accountKey  []byte
+	sender      autorest.Sender
+}
+
+func (c *ArmClient) getDataLakeGen2ClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*storageDataLakeGen2Client, bool, error) {
+	account, err := c.storageServiceClient.GetProperties(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		if utils.ResponseWasNotFound(account.Response) {
+			return nil, false, nil
+		}
+
+		return nil, true, fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %+v", storageAccountName, resourceGroupName, err)
+	}
+
+	props := account.AccountProperties
+	if props == nil || props.IsHnsEnabled == nil || !*props.IsHnsEnabled {
+		return nil, true, fmt.Errorf("Data Lake Storage Gen2 can only be used with Storage Accounts where `is_hns_enabled` is `true` - but it's not enabled for Storage Account %q (Resource Group %q)", storageAccountName, resourceGroupName)
+	}
+	if props.PrimaryEndpoints == nil || props.PrimaryEndpoints.Dfs == nil {
+		return nil, true, fmt.Errorf("The DFS Endpoint was not returned for Storage Account %q (Resource Group %q)", storageAccountName, resourceGroupName)
+	}
+
+	key, accountExists, err := c.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		return nil, accountExists, err
+	}
+	if !accountExists {
+		return nil, false, nil
+	}
+
+	accountKey, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, true, fmt.Errorf("Error decoding the Key for Storage Account %q: %s", storageAccountName, err)
+	}
+
+	sender := c.sender
+	if sender == nil {
+		sender = &http.Client{}
+	}
+
+	client := storageDataLakeGen2Client{
+		endpoint:    strings.TrimSuffix(*props.PrimaryEndpoints.Dfs, "/"),
+		accountName: storageAccountName,
+		accountKey:  accountKey,
+		sender:      sender,
+	}
+	return &client, true, nil
+}
+
+func (c storageDataLakeGen2Client) CreateFileSystem(ctx context.Context, fileSystemName string, properties map[string]string) error {
+	headers := map[string]string{
+		"x-ms-properties": storageDataLakeGen2FormatProperties(properties),
+	}
+	_, err := c.send(ctx, http.MethodPut, fileSystemName, url.Values{"resource": {"filesystem"}}, headers, http.StatusCreated)
+	return err
+}
+
+func (c storageDataLakeGen2Client) GetFileSystemProperties(ctx context.Context, fileSystemName string) (map[string]string, error) {
+	resp, err := c.send(ctx, http.MethodHead, fileSystemName, url.Values{"resource": {"filesystem"}}, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return storageDataLakeGen2ParseProperties(resp.Get("x-ms-properties"))
+}
+
+func (c storageDataLakeGen2Client) SetFileSystemProperties(ctx context.Context, fileSystemName string, properties map[string]string) error {
+	headers := map[string]string{
+		"x-ms-properties": storageDataLakeGen2FormatProperties(properties),
+	}
+	_, err := c.send(ctx, http.MethodPatch, fileSystemName, url.Values{"resource": {"filesystem"}}, headers, http.StatusOK)
+	return err
+}
+
+func (c storageDataLakeGen2Client) DeleteFileSystem(ctx context.Context, fileSystemName string) error {
+	_, err := c.send(ctx, http.MethodDelete, fileSystemName, url.Values{"resource": {"filesystem"}}, nil, http.StatusAccepted)
+	return err
+}
+
+func (c storageDataLakeGen2Client) CreatePath(ctx context.Context, fileSystemName, path, resource string) error {
+	_, err := c.send(ctx, http.MethodPut, fileSystemName+"/"+path, url.Values{"resource": {resource}}, nil, http.StatusCreated)
+	return err
+}
+
+// GetPathResourceType returns the type of the Path - either `directory` or `file`
+func (c storageDataLakeGen2Client) GetPathResourceType(ctx context.Context, fileSystemName, path string) (string, error) {
+	resp, err := c.send(ctx, http.MethodHead, fileSystemName+"/"+path, url.Values{}, nil, http.StatusOK)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Get("x-ms-resource-type"), nil
+}
+
+func (c storageDataLakeGen2Client) GetPathAccessControl(ctx context.Context, fileSystemName, path string) (*storageDataLakeGen2AccessControl, error) {
+	resp, err := c.send(ctx, http.MethodHead, fileSystemName+"/"+path, url.Values{"action": {"getAccessControl"}}, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storageDataLakeGen2AccessControl{
+		Owner: resp.Get("x-ms-owner"),
+		Group: resp.Get("x-ms-group"),
+		ACL:   resp.Get("x-ms-acl"),
+	}, nil
+}
+
+// SetPathAccessControl sets the Owner, Group and Access Control List of the Path - where any which are empty are
+// left unchanged
+func (c storageDataLakeGen2Client) SetPathAccessControl(ctx context.Context, fileSystemName, path string, input storageDataLakeGen2AccessControl) error {
+	headers := map[string]string{}
+	if input.Owner != "" {
+		headers["x-ms-owner"] = input.Owner
+	}
+	if input.Group != "" {
+		headers["x-ms-group"] = input.Group
+	}
+	if input.ACL != "" {
+		headers["x-ms-acl"] = input.ACL
+	}
+
+	_, err := c.send(ctx, http.MethodPatch, fileSystemName+"/"+path, url.Values{"action": {"setAccessControl"}}, headers, http.StatusOK)
+	return err
+}
+
+func (c storageDataLakeGen2Client) DeletePath(ctx context.Context, fileSystemName, path string, recursive bool) error {
+	query := url.Values{
+		"recursive": {strconv.FormatBool(recursive)},
+	}
+	_, err := c.send(ctx, http.MethodDelete, fileSystemName+"/"+path, query, nil, http.StatusOK)
+	return err
+}
+
+func (c storageDataLakeGen2Client) send(ctx context.Context, method, path string, query url.Values, headers map[string]string, expectedStatusCode int) (http.Header, error) {
+	uri := fmt.Sprintf("%s/%s", c.endpoint, (&url.URL{Path: path}).EscapedPath())
+	if len(query) > 0 {
+		uri = fmt.Sprintf("%s?%s", uri, query.Encode())
+	}
+
+	req, err := http.NewRequest(method, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("x-ms-version", storageDataLakeGen2APIVersion)
+	req.Header.Set("x-ms-date", time.Now().UTC().Format(http.TimeFormat))
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	if method == http.MethodPut || method == http.MethodPatch {
+		req.Header.Set("Content-Length", "0")
+	}
+	req.Header.Set("Authorization", c.sharedKeyAuthorization(req))
+
+	resp, err := c.sender.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != expectedStatusCode {
+		// the body isn't returned for HEAD requests, in which case the Code is only available in a header
+		serviceErr := storageDataLakeGen2Error{
+			StatusCode: resp.StatusCode,
+			Code:       resp.Header.Get("x-ms-error-code"),
+		}
+		var errorBody struct {
+			Error struct {
+				Code    string `json:"code"`
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		if err := json.Unmarshal(body, &errorBody); err == nil {
+			if errorBody.Error.Code != "" {
+				serviceErr.Code = errorBody.Error.Code
+			}
+			serviceErr.Message = errorBody.Error.Message
+		}
+		return nil, serviceErr
+	}
+
+	return resp.Header, nil
+}
+
+// sharedKeyAuthorization returns the value of the Authorization header for the request, signed using the Storage
+// Account Key as documented at https://docs.microsoft.com/en-us/rest/api/storageservices/authorize-with-shared-key
+func (c storageDataLakeGen2Client) sharedKeyAuthorization(req *http.Request) string {
+	contentLength := req.Header.Get("Content-Length")
+	if contentLength == "0" {
+		contentLength = ""
+	}
+
+	stringToSign := strings.Join([]string{
+		req.Method,
+		req.Header.Get("Content-Encoding"),
+		req.Header.Get("Content-Language"),
+		contentLength,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		// the Date is empty since the `x-ms-date` header is used instead
+		"",
+		req.Header.Get("If-Modified-Since"),
+		req.Header.Get("If-Match"),
+		req.Header.Get("If-None-Match"),
+		req.Header.Get("If-Unmodified-Since"),
+		req.Header.Get("Range"),
+		storageDataLakeGen2CanonicalizedHeaders(req.Header) + storageDataLakeGen2CanonicalizedResource(c.accountName, req.URL),
+	}, "\n")
+
+	mac := hmac.New(sha256.New, c.accountKey)
+	mac.Write([]byte(stringToSign))
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	return fmt.Sprintf("SharedKey %s:%s", c.accountName, signature)
+}
+
+func storageDataLakeGen2CanonicalizedHeaders(headers http.Header) string {
+	names := make([]string, 0)
+	values := make(map[string]string)
+	for k, v := range headers {
+		name := strings.ToLower(k)
+		if !strings.HasPrefix(name, "x-ms-") {
+			continue
+		}
+
+		names = append(names, name)
+		values[name] = strings.TrimSpace(strings.Join(v, ","))
+	}
+	sort.Strings(names)
+
+	var canonicalized strings.Builder
+	for _, name := range names {
+		canonicalized.WriteString(fmt.Sprintf("%s:%s\n", name, values[name]))
+	}
+
+	return canonicalized.String()
+}
+
+func storageDataLakeGen2CanonicalizedResource(accountName string, uri *url.URL) string {
+	canonicalized := fmt.Sprintf("/%s%s", accountName, uri.EscapedPath())
+
+	// query parameter names are case-insensitive, so the values of parameters with the same name are combined
+	query := make(map[string][]string)
+	for k, v := range uri.Query() {
+		name := strings.ToLower(k)
+		query[name] = append(query[name], v...)
+	}
+
+	names := make([]string, 0)
+	for k := range query {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		values := query[name]
+		sort.Strings(values)
+		canonicalized += fmt.Sprintf("\n%s:%s", name, strings.Join(values, ","))
+	}
+
+	return canonicalized
+}
+
+// storageDataLakeGen2FormatProperties formats the properties in the format used by the `x-ms-properties` header,
+// which is a comma-separated list of `key=value` pairs where each value is base64-encoded
+func storageDataLakeGen2FormatProperties(input map[string]string) string {
+	keys := make([]string, 0)
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	properties := make([]string, 0)
+	for _, k := range keys {
+		properties = append(properties, fmt.Sprintf("%s=%s", k, base64.StdEncoding.EncodeToString([]byte(input[k]))))
+	}
+
+	return strings.Join(properties, ",")
+}
+
+func storageDataLakeGen2ParseProperties(input string) (map[string]string, error) {
+	properties := make(map[string]string)
+	if input == "" {
+		return properties, nil
+	}
+
+	for _, property := range strings.Split(input, ",") {
+		// the value is base64-encoded, so may contain `=`
+		segments := strings.SplitN(property, "=", 2)
+		if len(segments) != 2 {
+			return nil, fmt.Errorf("Expected the property %q to be in the format `key=value`", property)
+		}
+
+		value, err := base64.StdEncoding.DecodeString(segments[1])
+		if err != nil {
+			return nil, fmt.Errorf("Error decoding the value of the property %q: %s", segments[0], err)
+		}
+		properties[segments[0]] = string(value)
+	}
+
+	return properties, nil
+}
+
+// storageDataLakeGen2WasNotFound returns whether the error is because the File System or Path doesn't exist
+func storageDataLakeGen2WasNotFound(err error) bool {
+	if v, ok := err.(storageDataLakeGen2Error); ok {
+		return v.StatusCode == http.StatusNotFound
+	}
+
+	return false
+}
+
+// storageDataLakeGen2ID is the ID of a File System (or a Path within a File System), which is the URL of the resource
+// on the DFS Endpoint - e.g. `https://account1.dfs.core.windows.net/filesystem1/path/to/directory`
+type storageDataLakeGen2ID struct {
+	AccountName    string
+	FileSystemName string
+	Path           string
+}
+
+func parseStorageDataLakeGen2ID(input string) (*storageDataLakeGen2ID, error) {
+	uri, err := url.Parse(input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a URI: %+v", input, err)
+	}
+
+	if uri.Scheme != "https" || uri.Host == "" {
+		return nil, fmt.Errorf("Expected the ID %q to be a URI in the format `https://{account}.dfs.{suffix}/{filesystem}`", input)
+	}
+
+	hostSegments := strings.SplitN(uri.Host, ".", 3)
+	if len(hostSegments) != 3 || hostSegments[1] != "dfs" {
+		return nil, fmt.Errorf("Expected the host of the ID %q to be a DFS Endpoint in the format `{account}.dfs.{suffix}`", input)
+	}
+
+	pathSegments := strings.SplitN(strings.TrimPrefix(uri.Path, "/"), "/", 2)
+	if pathSegments[0] == "" {
+		return nil, fmt.Errorf("Expected the ID %q to contain the name of a File System", input)
+	}
+
+	id := storageDataLakeGen2ID{
+		AccountName:    hostSegments[0],
+		FileSystemName: pathSegments[0],
+	}
+	if len(pathSegments) == 2 {
+		id.Path = strings.TrimSuffix(pathSegments[1], "/")
+	}
+
+	return &id, nil
+}
+
+func (c storageDataLakeGen2Client) fileSystemID(fileSystemName string) string {
+	return fmt.Sprintf("%s/%s", c.endpoint, fileSystemName)
+}
+
+func (c storageDataLakeGen2Client) pathID(fileSystemName, path string) string {
+	return fmt.Sprintf("%s/%s/%s", c.endpoint, fileSystemName, path)
+}
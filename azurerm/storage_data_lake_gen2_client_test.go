@@ -0,0 +1,279 @@
+package azurerm
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func testStorageDataLakeGen2Client(t *testing.T, handler http.HandlerFunc) (*storageDataLakeGen2Client, func()) {
+	server := httptest.NewServer(handler)
+	client := &storageDataLakeGen2Client{
+		endpoint:    server.URL,
+		accountName: "account1",
+		accountKey:  []byte("secret"),
+		sender:      server.Client(),
+	}
+	return client, server.Close
+}
+
+func TestStorageDataLakeGen2Client_createFileSystem(t *testing.T) {
+	client, closeFunc := testStorageDataLakeGen2Client(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Fatalf("Expected a PUT but got %q", r.Method)
+		}
+		if r.URL.Path != "/filesystem1" {
+			t.Fatalf("Expected the path to be %q but got %q", "/filesystem1", r.URL.Path)
+		}
+		if v := r.URL.Query().Get("resource"); v != "filesystem" {
+			t.Fatalf("Expected `resource` to be %q but got %q", "filesystem", v)
+		}
+		if v := r.Header.Get("x-ms-version"); v != storageDataLakeGen2APIVersion {
+			t.Fatalf("Expected `x-ms-version` to be %q but got %q", storageDataLakeGen2APIVersion, v)
+		}
+		if v := r.Header.Get("x-ms-properties"); v != "hello=d29ybGQ=" {
+			t.Fatalf("Expected `x-ms-properties` to be %q but got %q", "hello=d29ybGQ=", v)
+		}
+		if v := r.Header.Get("Authorization"); !strings.HasPrefix(v, "SharedKey account1:") {
+			t.Fatalf("Expected a SharedKey Authorization header but got %q", v)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+	})
+	defer closeFunc()
+
+	if err := client.CreateFileSystem(context.TODO(), "filesystem1", map[string]string{"hello": "world"}); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+}
+
+func TestStorageDataLakeGen2Client_getPathAccessControl(t *testing.T) {
+	client, closeFunc := testStorageDataLakeGen2Client(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodHead {
+			t.Fatalf("Expected a HEAD but got %q", r.Method)
+		}
+		if r.URL.Path != "/filesystem1/some/directory" {
+			t.Fatalf("Expected the path to be %q but got %q", "/filesystem1/some/directory", r.URL.Path)
+		}
+		if v := r.URL.Query().Get("action"); v != "getAccessControl" {
+			t.Fatalf("Expected `action` to be %q but got %q", "getAccessControl", v)
+		}
+
+		w.Header().Set("x-ms-owner", "$superuser")
+		w.Header().Set("x-ms-group", "$superuser")
+		w.Header().Set("x-ms-acl", "user::rwx,group::r-x,other::---")
+		w.WriteHeader(http.StatusOK)
+	})
+	defer closeFunc()
+
+	accessControl, err := client.GetPathAccessControl(context.TODO(), "filesystem1", "some/directory")
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if accessControl.Owner != "$superuser" || accessControl.Group != "$superuser" {
+		t.Fatalf("Expected the Owner and Group to be `$superuser` but got %+v", accessControl)
+	}
+	if accessControl.ACL != "user::rwx,group::r-x,other::---" {
+		t.Fatalf("Expected the ACL to be %q but got %q", "user::rwx,group::r-x,other::---", accessControl.ACL)
+	}
+}
+
+func TestStorageDataLakeGen2Client_notFound(t *testing.T) {
+	client, closeFunc := testStorageDataLakeGen2Client(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("x-ms-error-code", "FilesystemNotFound")
+		w.WriteHeader(http.StatusNotFound)
+	})
+	defer closeFunc()
+
+	_, err := client.GetFileSystemProperties(context.TODO(), "filesystem1")
+	if err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+	if !storageDataLakeGen2WasNotFound(err) {
+		t.Fatalf("Expected a Not Found error but got: %+v", err)
+	}
+	if v := err.(storageDataLakeGen2Error).Code; v != "FilesystemNotFound" {
+		t.Fatalf("Expected the Code to be %q but got %q", "FilesystemNotFound", v)
+	}
+}
+
+func TestStorageDataLakeGen2Client_errorBody(t *testing.T) {
+	client, closeFunc := testStorageDataLakeGen2Client(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"error":{"code":"FilesystemAlreadyExists","message":"The specified filesystem already exists."}}`))
+	})
+	defer closeFunc()
+
+	err := client.CreateFileSystem(context.TODO(), "filesystem1", map[string]string{})
+	if err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+
+	serviceErr := err.(storageDataLakeGen2Error)
+	if serviceErr.StatusCode != http.StatusConflict || serviceErr.Code != "FilesystemAlreadyExists" || serviceErr.Message != "The specified filesystem already exists." {
+		t.Fatalf("Expected the error to be parsed from the body but got %+v", serviceErr)
+	}
+	if storageDataLakeGen2WasNotFound(err) {
+		t.Fatalf("Expected the error not to be a Not Found error")
+	}
+}
+
+func TestStorageDataLakeGen2Client_sharedKeyAuthorization(t *testing.T) {
+	client := storageDataLakeGen2Client{
+		accountName: "account1",
+		accountKey:  []byte("secret"),
+	}
+
+	req, err := http.NewRequest(http.MethodPatch, "https://account1.dfs.core.windows.net/filesystem1/dir?action=setAccessControl", nil)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	req.Header.Set("Content-Length", "0")
+	req.Header.Set("x-ms-version", "2018-11-09")
+	req.Header.Set("x-ms-date", "Mon, 01 Jul 2019 00:00:00 GMT")
+	req.Header.Set("x-ms-acl", "user::rwx")
+
+	// the Content-Length is omitted when it's zero, and the Date is omitted in favour of `x-ms-date`
+	expectedStringToSign := "PATCH\n\n\n\n\n\n\n\n\n\n\n\n" +
+		"x-ms-acl:user::rwx\nx-ms-date:Mon, 01 Jul 2019 00:00:00 GMT\nx-ms-version:2018-11-09\n" +
+		"/account1/filesystem1/dir\naction:setAccessControl"
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte(expectedStringToSign))
+	expected := fmt.Sprintf("SharedKey account1:%s", base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+
+	if actual := client.sharedKeyAuthorization(req); actual != expected {
+		t.Fatalf("Expected the Authorization to be %q but got %q", expected, actual)
+	}
+}
+
+func TestStorageDataLakeGen2CanonicalizedResource(t *testing.T) {
+	testData := []struct {
+		Name     string
+		URI      string
+		Expected string
+	}{
+		{
+			Name:     "File System",
+			URI:      "https://account1.dfs.core.windows.net/filesystem1?resource=filesystem",
+			Expected: "/account1/filesystem1\nresource:filesystem",
+		},
+		{
+			Name:     "Multiple Query Parameters",
+			URI:      "https://account1.dfs.core.windows.net/filesystem1/dir?recursive=true&Action=b&action=a",
+			Expected: "/account1/filesystem1/dir\naction:a,b\nrecursive:true",
+		},
+		{
+			Name:     "No Query Parameters",
+			URI:      "https://account1.dfs.core.windows.net/filesystem1/some%20dir",
+			Expected: "/account1/filesystem1/some%20dir",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		uri, err := url.Parse(v.URI)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if actual := storageDataLakeGen2CanonicalizedResource("account1", uri); actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestStorageDataLakeGen2Properties_roundTrip(t *testing.T) {
+	input := map[string]string{
+		"hello":   "world",
+		"padding": "a",
+	}
+
+	formatted := storageDataLakeGen2FormatProperties(input)
+	if formatted != "hello=d29ybGQ=,padding=YQ==" {
+		t.Fatalf("Expected the properties to be formatted as %q but got %q", "hello=d29ybGQ=,padding=YQ==", formatted)
+	}
+
+	parsed, err := storageDataLakeGen2ParseProperties(formatted)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if len(parsed) != 2 || parsed["hello"] != "world" || parsed["padding"] != "a" {
+		t.Fatalf("Expected the properties to round-trip but got %+v", parsed)
+	}
+
+	if _, err := storageDataLakeGen2ParseProperties("hello"); err == nil {
+		t.Fatalf("Expected an error parsing a property without a value but didn't get one")
+	}
+}
+
+func TestParseStorageDataLakeGen2ID(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected *storageDataLakeGen2ID
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Name:     "Blob Endpoint",
+			Input:    "https://account1.blob.core.windows.net/filesystem1",
+			Expected: nil,
+		},
+		{
+			Name:     "No File System",
+			Input:    "https://account1.dfs.core.windows.net/",
+			Expected: nil,
+		},
+		{
+			Name:  "File System",
+			Input: "https://account1.dfs.core.windows.net/filesystem1",
+			Expected: &storageDataLakeGen2ID{
+				AccountName:    "account1",
+				FileSystemName: "filesystem1",
+			},
+		},
+		{
+			Name:  "Path",
+			Input: "https://account1.dfs.core.chinacloudapi.cn/filesystem1/some/directory",
+			Expected: &storageDataLakeGen2ID{
+				AccountName:    "account1",
+				FileSystemName: "filesystem1",
+				Path:           "some/directory",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := parseStorageDataLakeGen2ID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got %+v", actual)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
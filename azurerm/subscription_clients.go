@@ -0,0 +1,76 @@
+package azurerm
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// subscriptionClients builds and caches an ArmClient for each Subscription other than the one the Provider
+// is configured for, using the same credentials - which allows the Subnet Association, Key Vault Access Policy and
+// Virtual Machine Data Disk Attachment resources to reference resources in another Subscription without a Provider alias.
+type subscriptionClients struct {
+	endpoint      string
+	graphEndpoint string
+	auth          autorest.Authorizer
+	graphAuth     autorest.Authorizer
+	keyVaultAuth  autorest.Authorizer
+
+	lock    sync.Mutex
+	clients map[string]*ArmClient
+}
+
+func newSubscriptionClients(endpoint, graphEndpoint string, auth, graphAuth, keyVaultAuth autorest.Authorizer) *subscriptionClients {
+	return &subscriptionClients{
+		endpoint:      endpoint,
+		graphEndpoint: graphEndpoint,
+		auth:          auth,
+		graphAuth:     graphAuth,
+		keyVaultAuth:  keyVaultAuth,
+		clients:       make(map[string]*ArmClient),
+	}
+}
+
+// clientForSubscription returns an ArmClient for the specified Subscription - which is this ArmClient when the
+// Subscription is the one the Provider is configured for, otherwise one which is built the first time it's needed
+func (c *ArmClient) clientForSubscription(subscriptionId string) *ArmClient {
+	if subscriptionId == "" || strings.EqualFold(subscriptionId, c.subscriptionId) || c.subscriptions == nil {
+		return c
+	}
+
+	c.subscriptions.lock.Lock()
+	defer c.subscriptions.lock.Unlock()
+
+	key := strings.ToLower(subscriptionId)
+	client, ok := c.subscriptions.clients[key]
+	if !ok {
+		client = &ArmClient{
+			clientId:                 c.clientId,
+			tenantId:                 c.tenantId,
+			subscriptionId:           subscriptionId,
+			partnerId:                c.partnerId,
+			usingServicePrincipal:    c.usingServicePrincipal,
+			environment:              c.environment,
+			skipProviderRegistration: c.skipProviderRegistration,
+			features:                 c.features,
+			tagsPolicy:               c.tagsPolicy,
+			sender:                   c.sender,
+			tracer:                   c.tracer,
+		}
+
+		s := c.subscriptions
+		client.registerClients(s.endpoint, s.graphEndpoint, subscriptionId, c.tenantId, s.auth, s.graphAuth, s.keyVaultAuth)
+
+		// the cache is shared so that each Subscription only has a single ArmClient
+		client.subscriptions = s
+		s.clients[key] = client
+	}
+
+	// the StopContext is specific to each operation (for example it contains the Span used for tracing),
+	// so a copy of the cached ArmClient is returned using this ArmClient's StopContext
+	scoped := *client
+	scoped.StopContext = c.StopContext
+
+	return &scoped
+}
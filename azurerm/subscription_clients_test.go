@@ -0,0 +1,49 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/armmock"
+)
+
+func TestArmClientForSubscription(t *testing.T) {
+	server := armmock.NewServer()
+	defer server.Close()
+
+	client := testOfflineArmClient(server.URL, offlineSubscriptionId, server.Transport())
+
+	if actual := client.clientForSubscription(offlineSubscriptionId); actual != client {
+		t.Fatalf("Expected the same ArmClient to be returned for the configured Subscription")
+	}
+	if actual := client.clientForSubscription(""); actual != client {
+		t.Fatalf("Expected the same ArmClient to be returned when no Subscription is specified")
+	}
+
+	otherSubscriptionId := "11111111-1111-1111-1111-111111111111"
+	other := client.clientForSubscription(otherSubscriptionId)
+	if other == client {
+		t.Fatalf("Expected a different ArmClient to be returned for another Subscription")
+	}
+	if other.subscriptionId != otherSubscriptionId {
+		t.Fatalf("Expected the Subscription ID to be %q but got %q", otherSubscriptionId, other.subscriptionId)
+	}
+	client.clientForSubscription(otherSubscriptionId)
+	if len(client.subscriptions.clients) != 1 {
+		t.Fatalf("Expected the ArmClient for another Subscription to be cached")
+	}
+	if fromOther := other.clientForSubscription(offlineSubscriptionId); fromOther == other {
+		t.Fatalf("Expected the ArmClient for another Subscription to be able to build clients for other Subscriptions")
+	}
+
+	// requests made using the ArmClient for another Subscription should be sent to that Subscription
+	subnetId := fmt.Sprintf("/subscriptions/%s/resourceGroups/hub/providers/Microsoft.Network/virtualNetworks/hub/subnets/default", otherSubscriptionId)
+	if _, err := other.subnetClient.Get(client.StopContext, "hub", "hub", "default", ""); err == nil {
+		t.Fatalf("Expected the Subnet not to exist")
+	}
+	requests := server.Requests()
+	expected := fmt.Sprintf("GET %s", subnetId)
+	if len(requests) != 1 || requests[0] != expected {
+		t.Fatalf("Expected the request %q but got %+v", expected, requests)
+	}
+}
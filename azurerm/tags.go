@@ -114,3 +114,143 @@ func flattenAndSetTags(d *schema.ResourceData, tagMap map[string]*string) {
 
 	d.Set("tags", output)
 }
+
+// tagsPolicy contains the Provider-level configuration for tags, which is applied to each resource supporting tags
+type tagsPolicy struct {
+	// defaultTags are merged into the tags of each resource, where the tags defined on the resource take precedence
+	defaultTags map[string]string
+
+	// ignoredTagPrefixes are the prefixes of tags which are managed outside of Terraform (for example by Azure Policy)
+	ignoredTagPrefixes []string
+}
+
+func expandTagsPolicy(defaultTags map[string]interface{}, ignoredTagPrefixes []interface{}) tagsPolicy {
+	policy := tagsPolicy{
+		defaultTags:        make(map[string]string, len(defaultTags)),
+		ignoredTagPrefixes: make([]string, 0, len(ignoredTagPrefixes)),
+	}
+
+	for k, v := range defaultTags {
+		//Validate should have ignored this error already
+		value, _ := tagValueToString(v)
+		policy.defaultTags[k] = value
+	}
+
+	for _, v := range ignoredTagPrefixes {
+		policy.ignoredTagPrefixes = append(policy.ignoredTagPrefixes, v.(string))
+	}
+
+	return policy
+}
+
+// isManagedOutsideOfResource returns whether the tag with the specified key and value is either inherited from the
+// `default_tags` defined on the Provider, or is ignored since it's managed outside of Terraform
+func (p tagsPolicy) isManagedOutsideOfResource(key string, value string) bool {
+	for _, prefix := range p.ignoredTagPrefixes {
+		if strings.HasPrefix(strings.ToLower(key), strings.ToLower(prefix)) {
+			return true
+		}
+	}
+
+	defaultValue, ok := p.defaultTags[key]
+	return ok && defaultValue == value
+}
+
+// mergeInto merges the `default_tags` defined on the Provider into the tags for the resource - retaining
+// any existing tags which are managed outside of Terraform, so these aren't removed during an update
+func (p tagsPolicy) mergeInto(d *schema.ResourceData) error {
+	if len(p.defaultTags) == 0 && len(p.ignoredTagPrefixes) == 0 {
+		return nil
+	}
+
+	old, new := d.GetChange("tags")
+	tags := make(map[string]interface{})
+
+	for k, v := range old.(map[string]interface{}) {
+		value, _ := tagValueToString(v)
+		if _, isDefault := p.defaultTags[k]; !isDefault && p.isManagedOutsideOfResource(k, value) {
+			tags[k] = value
+		}
+	}
+
+	for k, v := range p.defaultTags {
+		tags[k] = v
+	}
+
+	for k, v := range new.(map[string]interface{}) {
+		tags[k] = v
+	}
+
+	return d.Set("tags", tags)
+}
+
+// suppressDiff suppresses the diff for tags which exist on the resource but aren't defined in the configuration,
+// where these are either inherited from the Provider or are managed outside of Terraform
+func (p tagsPolicy) suppressDiff(k, old, new string, d *schema.ResourceData) bool {
+	o, n := d.GetChange("tags")
+	oldTags := o.(map[string]interface{})
+	newTags := n.(map[string]interface{})
+
+	if k == "tags.%" {
+		count := 0
+		for key, v := range oldTags {
+			value, _ := tagValueToString(v)
+			if _, inConfig := newTags[key]; inConfig || !p.isManagedOutsideOfResource(key, value) {
+				count++
+			}
+		}
+
+		return count == len(newTags)
+	}
+
+	key := strings.TrimPrefix(k, "tags.")
+	if _, inConfig := newTags[key]; inConfig || new != "" {
+		return false
+	}
+
+	return p.isManagedOutsideOfResource(key, old)
+}
+
+// applyTagsPolicy configures each resource which supports tags to have the `default_tags` defined on the Provider
+// merged into its tags during a Create or Update, and suppresses the diff for tags which are either inherited from
+// the Provider or match one of the `ignore_tags` prefixes, so that these don't cause a perpetual diff
+func applyTagsPolicy(p *schema.Provider) {
+	policy := func() tagsPolicy {
+		if client, ok := p.Meta().(*ArmClient); ok {
+			return client.tagsPolicy
+		}
+
+		return tagsPolicy{}
+	}
+
+	for _, resource := range p.ResourcesMap {
+		tags, ok := resource.Schema["tags"]
+		if !ok || tags.Type != schema.TypeMap || !tags.Optional {
+			continue
+		}
+
+		tags.DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+			return policy().suppressDiff(k, old, new, d)
+		}
+
+		if create := resource.Create; create != nil {
+			resource.Create = func(d *schema.ResourceData, meta interface{}) error {
+				if err := meta.(*ArmClient).tagsPolicy.mergeInto(d); err != nil {
+					return fmt.Errorf("Error merging the default tags: %+v", err)
+				}
+
+				return create(d, meta)
+			}
+		}
+
+		if update := resource.Update; update != nil {
+			resource.Update = func(d *schema.ResourceData, meta interface{}) error {
+				if err := meta.(*ArmClient).tagsPolicy.mergeInto(d); err != nil {
+					return fmt.Errorf("Error merging the default tags: %+v", err)
+				}
+
+				return update(d, meta)
+			}
+		}
+	}
+}
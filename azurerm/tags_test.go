@@ -2,8 +2,13 @@ package azurerm
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestValidateMaximumNumberOfARMTags(t *testing.T) {
@@ -113,3 +118,128 @@ func TestFilterARMTags(t *testing.T) {
 		t.Fatalf("Expected %v in filtered tag map, got %v", valueData[1], *filtered["key2"])
 	}
 }
+
+func TestTagsPolicyMergeInto(t *testing.T) {
+	policy := tagsPolicy{
+		defaultTags: map[string]string{
+			"cost-center": "1234",
+			"environment": "production",
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"tags": tagsSchema()}, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"environment": "staging",
+			"team":        "networking",
+		},
+	})
+
+	if err := policy.mergeInto(d); err != nil {
+		t.Fatalf("Error merging tags: %+v", err)
+	}
+
+	expected := map[string]interface{}{
+		"cost-center": "1234",
+		"environment": "staging",
+		"team":        "networking",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestTagsPolicySuppressDiff(t *testing.T) {
+	policy := tagsPolicy{
+		defaultTags: map[string]string{
+			"cost-center": "1234",
+		},
+		ignoredTagPrefixes: []string{"policy-"},
+	}
+
+	testData := []struct {
+		Name         string
+		State        map[string]string
+		Config       map[string]interface{}
+		ExpectedDiff bool
+	}{
+		{
+			Name: "Inherited and Ignored Tags",
+			State: map[string]string{
+				"cost-center":   "1234",
+				"policy-source": "azure",
+				"team":          "networking",
+			},
+			Config: map[string]interface{}{
+				"team": "networking",
+			},
+			ExpectedDiff: false,
+		},
+		{
+			Name: "Inherited Tag with a different value",
+			State: map[string]string{
+				"cost-center": "5678",
+				"team":        "networking",
+			},
+			Config: map[string]interface{}{
+				"team": "networking",
+			},
+			ExpectedDiff: true,
+		},
+		{
+			Name: "Tag removed from the Configuration",
+			State: map[string]string{
+				"cost-center": "1234",
+				"team":        "networking",
+			},
+			Config:       map[string]interface{}{},
+			ExpectedDiff: true,
+		},
+		{
+			Name: "Tag added to the Configuration",
+			State: map[string]string{
+				"cost-center": "1234",
+			},
+			Config: map[string]interface{}{
+				"team": "networking",
+			},
+			ExpectedDiff: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		tags := tagsSchema()
+		tags.DiffSuppressFunc = policy.suppressDiff
+		resource := &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": tags,
+			},
+		}
+
+		attributes := map[string]string{
+			"tags.%": fmt.Sprintf("%d", len(v.State)),
+		}
+		for k, value := range v.State {
+			attributes["tags."+k] = value
+		}
+		state := &terraform.InstanceState{
+			ID:         "example",
+			Attributes: attributes,
+		}
+
+		raw, err := config.NewRawConfig(map[string]interface{}{"tags": v.Config})
+		if err != nil {
+			t.Fatalf("Error building config: %+v", err)
+		}
+
+		diff, err := resource.Diff(state, terraform.NewResourceConfig(raw), nil)
+		if err != nil {
+			t.Fatalf("Error computing diff: %+v", err)
+		}
+
+		if hasDiff := diff != nil && !diff.Empty(); hasDiff != v.ExpectedDiff {
+			t.Fatalf("Expected a diff to be %t but got %t: %+v", v.ExpectedDiff, hasDiff, diff)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tracing"
+)
+
+// buildTracer returns a Tracer which exports Spans to the specified file and/or OTLP Collector - or nil
+// when neither is configured, in which case tracing is disabled
+func buildTracer(filePath, otlpEndpoint string) (*tracing.Tracer, error) {
+	exporters := make([]tracing.Exporter, 0)
+
+	if filePath != "" {
+		exporter, err := tracing.NewFileExporter(filePath)
+		if err != nil {
+			return nil, err
+		}
+		exporters = append(exporters, exporter)
+	}
+
+	if otlpEndpoint != "" {
+		if u, err := url.Parse(otlpEndpoint); err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+			return nil, fmt.Errorf("The OTLP Endpoint %q must be a HTTP or HTTPS URL, e.g. `http://localhost:4318`", otlpEndpoint)
+		}
+		exporters = append(exporters, tracing.NewOTLPExporter(otlpEndpoint))
+	}
+
+	if len(exporters) == 0 {
+		return nil, nil
+	}
+
+	return tracing.NewTracer(tracing.NewMultiExporter(exporters...)), nil
+}
+
+// applyTracing wraps the CRUD functions of each Resource and Data Source such that a Span is recorded for each
+// Terraform operation, which is the parent of the Spans recorded for each request made to Azure during it
+func applyTracing(p *schema.Provider) {
+	for resourceType, resource := range p.ResourcesMap {
+		resource.Create = traceOperation(resourceType, "create", resource.Create)
+		resource.Read = traceOperation(resourceType, "read", resource.Read)
+		resource.Update = traceOperation(resourceType, "update", resource.Update)
+		resource.Delete = traceOperation(resourceType, "delete", resource.Delete)
+	}
+
+	for dataSourceType, dataSource := range p.DataSourcesMap {
+		dataSource.Read = traceOperation(dataSourceType, "read", dataSource.Read)
+	}
+}
+
+func traceOperation(resourceType, operation string, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client := meta.(*ArmClient)
+		if client.tracer == nil {
+			return f(d, meta)
+		}
+
+		ctx, span := client.tracer.Start(client.StopContext, fmt.Sprintf("%s.%s", resourceType, operation), tracing.SpanKindInternal)
+		span.SetAttribute("terraform.resource_type", resourceType)
+		span.SetAttribute("terraform.operation", operation)
+
+		// the Span is passed to the requests made during this operation via the StopContext, which is
+		// used as the parent context for the timeouts configured for each operation
+		scoped := *client
+		scoped.StopContext = ctx
+
+		err := f(d, &scoped)
+		if id := d.Id(); id != "" {
+			span.SetAttribute("terraform.resource_id", id)
+		}
+		span.End(err)
+
+		return err
+	}
+}
@@ -0,0 +1,121 @@
+package azurerm
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/armmock"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tracing"
+)
+
+type testRecordingExporter struct {
+	lock  sync.Mutex
+	spans []tracing.SpanData
+}
+
+func (e *testRecordingExporter) Export(spans []tracing.SpanData) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+func TestTraceOperation(t *testing.T) {
+	server := armmock.NewServer()
+	defer server.Close()
+	server.PollsBeforeCompletion = 2
+
+	exporter := &testRecordingExporter{}
+	client := testOfflineArmClientWithOptions(server.URL, offlineSubscriptionId, azure.SenderOptions{
+		Transport: server.Transport(),
+		Tracer:    tracing.NewTracer(exporter),
+	})
+
+	r := resourceArmResourceGroup()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":     "acctestRG-tracing",
+		"location": "westeurope",
+	})
+
+	create := traceOperation("azurerm_resource_group", "create", r.Create)
+	if err := create(d, client); err != nil {
+		t.Fatalf("Error creating Resource Group: %+v", err)
+	}
+
+	del := traceOperation("azurerm_resource_group", "delete", r.Delete)
+	if err := del(d, client); err != nil {
+		t.Fatalf("Error deleting Resource Group: %+v", err)
+	}
+
+	roots := make(map[string]tracing.SpanData)
+	for _, span := range exporter.spans {
+		if span.ParentSpanID == "" {
+			roots[span.Name] = span
+		}
+	}
+
+	createSpan, ok := roots["azurerm_resource_group.create"]
+	if !ok {
+		t.Fatalf("Expected a Span for the Create but got %+v", exporter.spans)
+	}
+	if v := createSpan.Attributes["terraform.resource_type"]; v != "azurerm_resource_group" {
+		t.Fatalf("Expected the Resource Type to be `azurerm_resource_group` but got %v", v)
+	}
+	if v := createSpan.Attributes["terraform.resource_id"]; v != d.Id() {
+		t.Fatalf("Expected the Resource ID to be %q but got %v", d.Id(), v)
+	}
+	if v, _ := createSpan.Attributes["azure.request_count"].(int64); v < 2 {
+		t.Fatalf("Expected at least 2 requests to be recorded for the Create but got %d", v)
+	}
+
+	deleteSpan, ok := roots["azurerm_resource_group.delete"]
+	if !ok {
+		t.Fatalf("Expected a Span for the Delete but got %+v", exporter.spans)
+	}
+	if _, ok := deleteSpan.Attributes["azure.lro.polling_time_ms"]; !ok {
+		t.Fatalf("Expected the polling time to be recorded for the Delete but got %+v", deleteSpan.Attributes)
+	}
+
+	polls := 0
+	for _, span := range exporter.spans {
+		if span.ParentSpanID == deleteSpan.SpanID && span.Attributes["azure.lro.polling"] == true {
+			if span.TraceID != deleteSpan.TraceID {
+				t.Fatalf("Expected the polling request to be a part of the same Trace as the Delete")
+			}
+			if v := span.Attributes["http.status_code"]; v != 200 {
+				t.Fatalf("Expected the polling request to return 200 but got %v", v)
+			}
+			polls++
+		}
+	}
+	if polls != 2 {
+		t.Fatalf("Expected 2 polling requests for the Delete but got %d", polls)
+	}
+}
+
+func TestTraceOperationWithoutTracer(t *testing.T) {
+	server := armmock.NewServer()
+	defer server.Close()
+
+	client := testOfflineArmClient(server.URL, offlineSubscriptionId, server.Transport())
+
+	called := false
+	operation := traceOperation("azurerm_resource_group", "read", func(d *schema.ResourceData, meta interface{}) error {
+		called = meta == client
+		return nil
+	})
+
+	if err := operation(nil, client); err != nil {
+		t.Fatalf("Error calling operation: %+v", err)
+	}
+	if !called {
+		t.Fatalf("Expected the operation to be called with the same ArmClient when tracing is disabled")
+	}
+
+	if traceOperation("azurerm_resource_group", "update", nil) != nil {
+		t.Fatalf("Expected no operation to be returned when the Resource doesn't support it")
+	}
+}
@@ -13,6 +13,10 @@ func ResponseWasNotFound(resp autorest.Response) bool {
 
 func ResponseErrorIsRetryable(err error) bool {
 	if arerr, ok := err.(autorest.DetailedError); ok {
+		if statusCode, ok := arerr.StatusCode.(int); ok && ResponseStatusCodeIsRetryable(statusCode) {
+			return true
+		}
+
 		err = arerr.Original
 	}
 
@@ -26,6 +30,21 @@ func ResponseErrorIsRetryable(err error) bool {
 	return false
 }
 
+// ResponseStatusCodeIsRetryable returns whether the request was throttled (429)
+// or failed with a transient server-side error (5xx) which can be retried
+func ResponseStatusCodeIsRetryable(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
 func responseWasStatusCode(resp autorest.Response, statusCode int) bool { // nolint: unparam
 	if r := resp.Response; r != nil {
 		if r.StatusCode == statusCode {
@@ -64,6 +64,12 @@ func TestResponseErrorIsRetryable(t *testing.T) {
 			Original: testNetError{true, true}}, true},
 		{"Unhandled error nested in autorest.DetailedError is not retryable", autorest.DetailedError{
 			Original: fmt.Errorf("Some other error")}, false},
+		{"Throttled autorest.DetailedError is retryable", autorest.DetailedError{
+			Original: fmt.Errorf("Some other error"), StatusCode: http.StatusTooManyRequests}, true},
+		{"Server error autorest.DetailedError is retryable", autorest.DetailedError{
+			Original: fmt.Errorf("Some other error"), StatusCode: http.StatusServiceUnavailable}, true},
+		{"Client error autorest.DetailedError is not retryable", autorest.DetailedError{
+			Original: fmt.Errorf("Some other error"), StatusCode: http.StatusBadRequest}, false},
 		{"nil is handled as non-retryable", nil, false},
 	}
 
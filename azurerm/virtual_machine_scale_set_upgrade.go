@@ -0,0 +1,276 @@
+package azurerm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// virtualMachineScaleSetRollingUpgradeNotFound is the state used when no Rolling Upgrade has been run for a
+// Virtual Machine Scale Set
+const virtualMachineScaleSetRollingUpgradeNotFound = "NotFound"
+
+// virtualMachineScaleSetClockSkewMargin is subtracted from the local time when Azure's time isn't available, so that
+// a Rolling Upgrade started by Azure isn't missed when the local clock is ahead
+const virtualMachineScaleSetClockSkewMargin = 5 * time.Minute
+
+// virtualMachineScaleSetModelUpdatedAt returns when the model of a Virtual Machine Scale Set was updated, according
+// to Azure - using the `Date` header of the response to the update, since the StartTime of a Rolling Upgrade is
+// Azure's time rather than the local time
+func virtualMachineScaleSetModelUpdatedAt(resp *http.Response, now time.Time) time.Time {
+	if resp != nil {
+		if v := resp.Header.Get("Date"); v != "" {
+			if date, err := http.ParseTime(v); err == nil {
+				return date
+			}
+
+			log.Printf("[DEBUG] Unable to parse the Date header %q", v)
+		}
+	}
+
+	return now.Add(-virtualMachineScaleSetClockSkewMargin)
+}
+
+// upgradeVirtualMachineScaleSetInstances applies the latest model of a Virtual Machine Scale Set to its instances
+// after the model has been updated. When the Upgrade Policy is `Manual` each instance which isn't running the latest
+// model is upgraded - and when it's `Rolling` an OS Rolling Upgrade is started if the Image changed (and the platform
+// hasn't started a Rolling Upgrade itself), then the Rolling Upgrade is waited on. Instances are upgraded by the
+// platform when the Upgrade Policy is `Automatic`, so there's nothing to do.
+func upgradeVirtualMachineScaleSetInstances(ctx context.Context, meta interface{}, resourceGroup, name, upgradeMode string, imageChanged bool, modelUpdatedAt time.Time) error {
+	switch strings.ToLower(upgradeMode) {
+	case strings.ToLower(string(compute.Manual)):
+		return upgradeVirtualMachineScaleSetInstancesManually(ctx, meta, resourceGroup, name)
+	case strings.ToLower(string(compute.Rolling)):
+		return upgradeVirtualMachineScaleSetInstancesRolling(ctx, meta, resourceGroup, name, imageChanged, modelUpdatedAt)
+	}
+
+	return nil
+}
+
+func upgradeVirtualMachineScaleSetInstancesManually(ctx context.Context, meta interface{}, resourceGroup, name string) error {
+	client := meta.(*ArmClient).vmScaleSetClient
+
+	instanceIds, err := listVirtualMachineScaleSetInstanceIDsNotOnLatestModel(ctx, meta, resourceGroup, name)
+	if err != nil {
+		return err
+	}
+	if len(instanceIds) == 0 {
+		log.Printf("[DEBUG] All instances of Virtual Machine Scale Set %q (Resource Group %q) are running the latest model", name, resourceGroup)
+		return nil
+	}
+
+	log.Printf("[INFO] Upgrading instances %s of Virtual Machine Scale Set %q (Resource Group %q) to the latest model..", strings.Join(instanceIds, ", "), name, resourceGroup)
+	input := compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
+		InstanceIds: &instanceIds,
+	}
+	future, err := client.UpdateInstances(ctx, resourceGroup, name, input)
+	if err != nil {
+		return fmt.Errorf("Error upgrading instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	waitErr := future.WaitForCompletionRef(ctx, client.Client)
+
+	failedInstanceIds, err := listVirtualMachineScaleSetInstanceIDsNotOnLatestModel(ctx, meta, resourceGroup, name)
+	if err != nil {
+		return err
+	}
+	if len(failedInstanceIds) > 0 {
+		return fmt.Errorf("Error upgrading Virtual Machine Scale Set %q (Resource Group %q) - instances %s aren't running the latest model: %v", name, resourceGroup, strings.Join(failedInstanceIds, ", "), waitErr)
+	}
+	if waitErr != nil {
+		return fmt.Errorf("Error waiting for the upgrade of instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, waitErr)
+	}
+
+	return nil
+}
+
+func upgradeVirtualMachineScaleSetInstancesRolling(ctx context.Context, meta interface{}, resourceGroup, name string, imageChanged bool, modelUpdatedAt time.Time) error {
+	client := meta.(*ArmClient).vmScaleSetRollingUpgradesClient
+
+	latest, err := client.GetLatest(ctx, resourceGroup, name)
+	if err != nil && !utils.ResponseWasNotFound(latest.Response) {
+		return fmt.Errorf("Error retrieving the latest Rolling Upgrade for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	// when the Image changed the Rolling Upgrade is either started by the platform or by us, either way it's the result of this change
+	startedByThisChange := imageChanged
+	if imageChanged && !virtualMachineScaleSetRollingUpgradeStartedSince(latest, modelUpdatedAt) {
+		log.Printf("[INFO] Starting an OS Rolling Upgrade for Virtual Machine Scale Set %q (Resource Group %q)..", name, resourceGroup)
+		future, err := client.StartOSUpgrade(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error starting an OS Rolling Upgrade for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		// the outcome of the upgrade is determined from the status of the Rolling Upgrade below, which includes the instances which failed
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			log.Printf("[DEBUG] Error waiting for the OS Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("Error determining the timeout for the Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	log.Printf("[DEBUG] Waiting for the Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q) to complete..", name, resourceGroup)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{string(compute.RollingUpgradeStatusCodeRollingForward)},
+		Target: []string{
+			string(compute.RollingUpgradeStatusCodeCompleted),
+			string(compute.RollingUpgradeStatusCodeCancelled),
+			string(compute.RollingUpgradeStatusCodeFaulted),
+			virtualMachineScaleSetRollingUpgradeNotFound,
+		},
+		Refresh:      virtualMachineScaleSetRollingUpgradeRefreshFunc(ctx, client, resourceGroup, name),
+		Timeout:      time.Until(deadline),
+		PollInterval: 30 * time.Second,
+	}
+
+	raw, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for the Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q) to complete: %+v", name, resourceGroup, err)
+	}
+
+	// a Rolling Upgrade which failed before the model was updated isn't the result of this change
+	status := raw.(compute.RollingUpgradeStatusInfo)
+	if !startedByThisChange && !virtualMachineScaleSetRollingUpgradeStartedSince(status, modelUpdatedAt) {
+		log.Printf("[DEBUG] The latest Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q) was started before the model was updated - ignoring", name, resourceGroup)
+		return nil
+	}
+
+	if upgradeErr := virtualMachineScaleSetRollingUpgradeError(status); upgradeErr != nil {
+		failedInstanceIds, err := listVirtualMachineScaleSetInstanceIDsNotOnLatestModel(ctx, meta, resourceGroup, name)
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Error upgrading Virtual Machine Scale Set %q (Resource Group %q) - instances %s aren't running the latest model: %+v", name, resourceGroup, strings.Join(failedInstanceIds, ", "), upgradeErr)
+	}
+
+	return nil
+}
+
+func virtualMachineScaleSetRollingUpgradeRefreshFunc(ctx context.Context, client compute.VirtualMachineScaleSetRollingUpgradesClient, resourceGroup, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.GetLatest(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return resp, virtualMachineScaleSetRollingUpgradeNotFound, nil
+			}
+
+			return nil, "", fmt.Errorf("Error retrieving the latest Rolling Upgrade for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		props := resp.RollingUpgradeStatusInfoProperties
+		if props == nil || props.RunningStatus == nil {
+			return resp, virtualMachineScaleSetRollingUpgradeNotFound, nil
+		}
+
+		if progress := props.Progress; progress != nil {
+			log.Printf("[DEBUG] Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q) is %q: %d successful, %d failed, %d in progress and %d pending", name, resourceGroup, props.RunningStatus.Code, virtualMachineScaleSetInstanceCount(progress.SuccessfulInstanceCount), virtualMachineScaleSetInstanceCount(progress.FailedInstanceCount), virtualMachineScaleSetInstanceCount(progress.InProgressInstanceCount), virtualMachineScaleSetInstanceCount(progress.PendingInstanceCount))
+		}
+
+		return resp, string(props.RunningStatus.Code), nil
+	}
+}
+
+func virtualMachineScaleSetInstanceCount(input *int32) int32 {
+	if input == nil {
+		return 0
+	}
+
+	return *input
+}
+
+// virtualMachineScaleSetRollingUpgradeStartedSince returns whether the Rolling Upgrade was started at or after the
+// specified time
+func virtualMachineScaleSetRollingUpgradeStartedSince(status compute.RollingUpgradeStatusInfo, since time.Time) bool {
+	props := status.RollingUpgradeStatusInfoProperties
+	if props == nil || props.RunningStatus == nil || props.RunningStatus.StartTime == nil {
+		return false
+	}
+
+	return !props.RunningStatus.StartTime.Time.Before(since)
+}
+
+// virtualMachineScaleSetRollingUpgradeError returns an error describing why the Rolling Upgrade didn't complete,
+// or nil if it completed successfully
+func virtualMachineScaleSetRollingUpgradeError(status compute.RollingUpgradeStatusInfo) error {
+	props := status.RollingUpgradeStatusInfoProperties
+	if props == nil || props.RunningStatus == nil || props.RunningStatus.Code == compute.RollingUpgradeStatusCodeCompleted {
+		return nil
+	}
+
+	message := fmt.Sprintf("the Rolling Upgrade is %q", string(props.RunningStatus.Code))
+	if progress := props.Progress; progress != nil && progress.FailedInstanceCount != nil {
+		message = fmt.Sprintf("%s with %d failed instances", message, *progress.FailedInstanceCount)
+	}
+	if apiErr := props.Error; apiErr != nil {
+		if apiErr.Code != nil {
+			message = fmt.Sprintf("%s (Code %q)", message, *apiErr.Code)
+		}
+		if apiErr.Message != nil {
+			message = fmt.Sprintf("%s: %s", message, *apiErr.Message)
+		}
+	}
+
+	return errors.New(message)
+}
+
+func listVirtualMachineScaleSetInstanceIDsNotOnLatestModel(ctx context.Context, meta interface{}, resourceGroup, name string) ([]string, error) {
+	client := meta.(*ArmClient).vmScaleSetVMsClient
+
+	instances := make([]compute.VirtualMachineScaleSetVM, 0)
+	results, err := client.ListComplete(ctx, resourceGroup, name, "", "", "")
+	if err != nil {
+		return nil, fmt.Errorf("Error listing instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	for results.NotDone() {
+		instances = append(instances, results.Value())
+		if err := results.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("Error listing instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return virtualMachineScaleSetInstanceIDsNotOnLatestModel(instances), nil
+}
+
+// virtualMachineScaleSetInstanceIDsNotOnLatestModel returns the (numerically) sorted IDs of the instances which aren't running the
+// latest model of the Virtual Machine Scale Set
+func virtualMachineScaleSetInstanceIDsNotOnLatestModel(instances []compute.VirtualMachineScaleSetVM) []string {
+	instanceIds := make([]string, 0)
+
+	for _, instance := range instances {
+		if instance.InstanceID == nil {
+			continue
+		}
+
+		props := instance.VirtualMachineScaleSetVMProperties
+		if props != nil && props.LatestModelApplied != nil && *props.LatestModelApplied {
+			continue
+		}
+
+		instanceIds = append(instanceIds, *instance.InstanceID)
+	}
+
+	sort.Slice(instanceIds, func(i, j int) bool {
+		first, firstErr := strconv.Atoi(instanceIds[i])
+		second, secondErr := strconv.Atoi(instanceIds[j])
+		if firstErr != nil || secondErr != nil {
+			return instanceIds[i] < instanceIds[j]
+		}
+
+		return first < second
+	})
+	return instanceIds
+}
@@ -0,0 +1,252 @@
+package azurerm
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestVirtualMachineScaleSetInstanceIDsNotOnLatestModel(t *testing.T) {
+	instance := func(id *string, latestModelApplied *bool) compute.VirtualMachineScaleSetVM {
+		return compute.VirtualMachineScaleSetVM{
+			InstanceID: id,
+			VirtualMachineScaleSetVMProperties: &compute.VirtualMachineScaleSetVMProperties{
+				LatestModelApplied: latestModelApplied,
+			},
+		}
+	}
+
+	testData := []struct {
+		Name      string
+		Instances []compute.VirtualMachineScaleSetVM
+		Expected  []string
+	}{
+		{
+			Name:      "No Instances",
+			Instances: []compute.VirtualMachineScaleSetVM{},
+			Expected:  []string{},
+		},
+		{
+			Name: "All On Latest Model",
+			Instances: []compute.VirtualMachineScaleSetVM{
+				instance(utils.String("0"), utils.Bool(true)),
+				instance(utils.String("1"), utils.Bool(true)),
+			},
+			Expected: []string{},
+		},
+		{
+			Name: "Some Not On Latest Model",
+			Instances: []compute.VirtualMachineScaleSetVM{
+				instance(utils.String("3"), utils.Bool(false)),
+				instance(utils.String("0"), utils.Bool(true)),
+				instance(utils.String("1"), nil),
+				{
+					InstanceID: utils.String("2"),
+				},
+			},
+			Expected: []string{"1", "2", "3"},
+		},
+		{
+			Name: "Sorted Numerically",
+			Instances: []compute.VirtualMachineScaleSetVM{
+				instance(utils.String("10"), utils.Bool(false)),
+				instance(utils.String("9"), utils.Bool(false)),
+				instance(utils.String("100"), utils.Bool(false)),
+			},
+			Expected: []string{"9", "10", "100"},
+		},
+		{
+			Name: "No Instance ID",
+			Instances: []compute.VirtualMachineScaleSetVM{
+				instance(nil, utils.Bool(false)),
+			},
+			Expected: []string{},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := virtualMachineScaleSetInstanceIDsNotOnLatestModel(v.Instances)
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestVirtualMachineScaleSetModelUpdatedAt(t *testing.T) {
+	now := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+	azureTime := time.Date(2019, 6, 1, 11, 50, 0, 0, time.UTC)
+
+	testData := []struct {
+		Name     string
+		Response *http.Response
+		Expected time.Time
+	}{
+		{
+			Name:     "No Response",
+			Response: nil,
+			Expected: now.Add(-virtualMachineScaleSetClockSkewMargin),
+		},
+		{
+			Name: "No Date Header",
+			Response: &http.Response{
+				Header: http.Header{},
+			},
+			Expected: now.Add(-virtualMachineScaleSetClockSkewMargin),
+		},
+		{
+			Name: "Invalid Date Header",
+			Response: &http.Response{
+				Header: http.Header{
+					"Date": []string{"yesterday"},
+				},
+			},
+			Expected: now.Add(-virtualMachineScaleSetClockSkewMargin),
+		},
+		{
+			Name: "Date Header",
+			Response: &http.Response{
+				Header: http.Header{
+					"Date": []string{azureTime.Format(http.TimeFormat)},
+				},
+			},
+			Expected: azureTime,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := virtualMachineScaleSetModelUpdatedAt(v.Response, now); !actual.Equal(v.Expected) {
+			t.Fatalf("Expected %s but got %s", v.Expected, actual)
+		}
+	}
+}
+
+func TestVirtualMachineScaleSetRollingUpgradeStartedSince(t *testing.T) {
+	since := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+	status := func(startTime *time.Time) compute.RollingUpgradeStatusInfo {
+		runningStatus := compute.RollingUpgradeRunningStatus{
+			Code: compute.RollingUpgradeStatusCodeRollingForward,
+		}
+		if startTime != nil {
+			runningStatus.StartTime = &date.Time{Time: *startTime}
+		}
+
+		return compute.RollingUpgradeStatusInfo{
+			RollingUpgradeStatusInfoProperties: &compute.RollingUpgradeStatusInfoProperties{
+				RunningStatus: &runningStatus,
+			},
+		}
+	}
+	before := since.Add(-1 * time.Minute)
+	after := since.Add(time.Minute)
+
+	testData := []struct {
+		Name     string
+		Status   compute.RollingUpgradeStatusInfo
+		Expected bool
+	}{
+		{
+			Name:     "No Rolling Upgrade",
+			Status:   compute.RollingUpgradeStatusInfo{},
+			Expected: false,
+		},
+		{
+			Name:     "No Start Time",
+			Status:   status(nil),
+			Expected: false,
+		},
+		{
+			Name:     "Started Before",
+			Status:   status(&before),
+			Expected: false,
+		},
+		{
+			Name:     "Started At",
+			Status:   status(&since),
+			Expected: true,
+		},
+		{
+			Name:     "Started After",
+			Status:   status(&after),
+			Expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := virtualMachineScaleSetRollingUpgradeStartedSince(v.Status, since); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestVirtualMachineScaleSetRollingUpgradeError(t *testing.T) {
+	status := func(code compute.RollingUpgradeStatusCode, failed *int32, apiErr *compute.APIError) compute.RollingUpgradeStatusInfo {
+		return compute.RollingUpgradeStatusInfo{
+			RollingUpgradeStatusInfoProperties: &compute.RollingUpgradeStatusInfoProperties{
+				RunningStatus: &compute.RollingUpgradeRunningStatus{
+					Code: code,
+				},
+				Progress: &compute.RollingUpgradeProgressInfo{
+					FailedInstanceCount: failed,
+				},
+				Error: apiErr,
+			},
+		}
+	}
+
+	testData := []struct {
+		Name     string
+		Status   compute.RollingUpgradeStatusInfo
+		Expected string
+	}{
+		{
+			Name:     "No Rolling Upgrade",
+			Status:   compute.RollingUpgradeStatusInfo{},
+			Expected: "",
+		},
+		{
+			Name:     "Completed",
+			Status:   status(compute.RollingUpgradeStatusCodeCompleted, utils.Int32(0), nil),
+			Expected: "",
+		},
+		{
+			Name:     "Cancelled",
+			Status:   status(compute.RollingUpgradeStatusCodeCancelled, nil, nil),
+			Expected: `the Rolling Upgrade is "Cancelled"`,
+		},
+		{
+			Name: "Faulted",
+			Status: status(compute.RollingUpgradeStatusCodeFaulted, utils.Int32(2), &compute.APIError{
+				Code:    utils.String("MaxUnhealthyInstancePercentExceededInRollingUpgrade"),
+				Message: utils.String("Too many instances are unhealthy."),
+			}),
+			Expected: `the Rolling Upgrade is "Faulted" with 2 failed instances (Code "MaxUnhealthyInstancePercentExceededInRollingUpgrade"): Too many instances are unhealthy.`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := virtualMachineScaleSetRollingUpgradeError(v.Status)
+		if v.Expected == "" {
+			if err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+			continue
+		}
+
+		if err == nil || err.Error() != v.Expected {
+			t.Fatalf("Expected the error %q but got: %v", v.Expected, err)
+		}
+	}
+}